@@ -64,6 +64,126 @@ const (
 	// to "delete" the resource manager will delete the AWS resource when the
 	// K8s resource is deleted. If this annotation is set to "retain" the
 	// resource manager will leave the AWS resource intact when the K8s resource
-	// is deleted.
+	// is deleted. If this annotation is set to "soft-delete" the AWS resource
+	// is marked as pending deletion and only deleted once the retention period
+	// configured with the soft-delete-retention-seconds flag has elapsed. If
+	// this annotation is set to "orphan" the K8s resource is deleted and the
+	// AWS resource is left intact, like with "retain".
 	AnnotationDeletionPolicy = AnnotationPrefix + "deletion-policy"
+	// AnnotationConfirmDestructiveUpdate is an annotation whose value is a
+	// boolean value. Changes to some Spec fields can only be applied by
+	// destroying and replacing the backend AWS resource. When such a change is
+	// made, the ACK service controller will not apply it until this annotation
+	// is set to "true" on the CR. The annotation is removed by the controller
+	// once the confirmed update has been applied.
+	AnnotationConfirmDestructiveUpdate = AnnotationPrefix + "confirm-destructive-update"
+	// AnnotationDeletionProtection is an annotation whose value is a boolean
+	// value. If this annotation is set to "true" on a CR, the ACK service
+	// controller refuses to delete the backend AWS resource when the CR is
+	// deleted, and keeps the CR around until the annotation is removed. The
+	// annotation has no effect when the deletion policy of the CR is "retain",
+	// since the backend AWS resource is not deleted in that case.
+	AnnotationDeletionProtection = AnnotationPrefix + "deletion-protection"
+	// AnnotationSoftDeleteAfter is an annotation set by the ACK service
+	// controller on CRs deleted with the "soft-delete" deletion policy. Its
+	// value is the RFC3339 time after which the backend AWS resource is
+	// deleted.
+	AnnotationSoftDeleteAfter = AnnotationPrefix + "soft-delete-after"
+	// AnnotationCancelSoftDelete is an annotation whose value is a boolean
+	// value. If this annotation is set to "true" on a CR pending soft
+	// deletion, the ACK service controller removes the pending deletion
+	// marker from the backend AWS resource, leaves it intact and lets the CR
+	// be deleted, as with the "retain" deletion policy.
+	AnnotationCancelSoftDelete = AnnotationPrefix + "cancel-soft-delete"
+	// AnnotationReconcileCount is an annotation whose value is the number of
+	// times the ACK service controller reconciled the CR since the CR was
+	// last synced or became terminal. The annotation is only set when the
+	// service controller is started with the
+	// --enable-reconcile-count-annotations flag, and is removed when the CR
+	// is synced or becomes terminal.
+	AnnotationReconcileCount = AnnotationPrefix + "reconcile-count"
+	// AnnotationReconcileErrorCount is an annotation whose value is the number
+	// of times the reconciliation of the CR failed since the CR was last
+	// synced or became terminal. Like AnnotationReconcileCount, it is only set
+	// when the --enable-reconcile-count-annotations flag is set.
+	AnnotationReconcileErrorCount = AnnotationPrefix + "reconcile-error-count"
+	// AnnotationBackoffState is an annotation whose value is a JSON document
+	// recording the number of consecutive failed reconciliations of the CR
+	// and the backoff applied after the last failure. The annotation is only
+	// set when the service controller is started with the
+	// --enable-backoff-persistence flag, and allows a restarted controller to
+	// resume the backoff instead of immediately retrying every failing CR.
+	AnnotationBackoffState = AnnotationPrefix + "backoff-state"
+	// AnnotationAdoptionObserved is an annotation set to "true" by the ACK
+	// service controller once it has observed an adopted CR for the first
+	// time. When the service controller is started with the
+	// --adoption-observe-first flag, the backend AWS resource of an adopted
+	// CR is not updated until this annotation is present, giving the user
+	// one reconciliation to confirm the adopted state before ACK starts
+	// enforcing the CR's Spec.
+	AnnotationAdoptionObserved = AnnotationPrefix + "adoption-observed"
+	// AnnotationApplyAfter is an annotation whose value is an RFC3339
+	// timestamp, e.g. "2024-01-31T22:00:00Z". While the timestamp is in the
+	// future, the ACK service controller does not update the backend AWS
+	// resource to match changes to the CR's Spec, and instead sets an
+	// ACK.Advisory condition announcing when the update is scheduled. The
+	// annotation has no effect on the creation or deletion of the AWS
+	// resource.
+	AnnotationApplyAfter = AnnotationPrefix + "apply-after"
+	// AnnotationReadOnly is an annotation whose value is a boolean value. If
+	// this annotation is set to "true" on a namespace, the ACK service
+	// controller only reads the backend AWS resources of the CRs in that
+	// namespace, reporting differences with their Spec in their conditions,
+	// and never creates, updates or deletes them. This allows the controller
+	// to run with a read-only IAM role in that namespace.
+	AnnotationReadOnly = AnnotationPrefix + "read-only"
+	// AnnotationConditionTransitions is an annotation whose value is a JSON
+	// list of the most recent transitions of the statuses of the CR's
+	// conditions, with the time and reason of each transition. The
+	// annotation is only set when the service controller is started with the
+	// --condition-transition-log-size and --persist-condition-transitions
+	// flags, and helps debugging CRs flapping between conditions.
+	AnnotationConditionTransitions = AnnotationPrefix + "condition-transitions"
+	// AnnotationExplain is an annotation whose value is a boolean value. If
+	// this annotation is set to "true" on a CR, the ACK service controller
+	// records the decisions it makes while reconciling the CR, e.g. which
+	// source supplied its AWS region or why the AWS resource was updated or
+	// requeued, in the CR's AnnotationExplanation annotation.
+	AnnotationExplain = AnnotationPrefix + "explain"
+	// AnnotationExplanation is an annotation whose value is a JSON list of
+	// the decisions made by the ACK service controller during the latest
+	// reconciliation of a CR annotated with AnnotationExplain.
+	AnnotationExplanation = AnnotationPrefix + "explanation"
+	// AnnotationCreateInProgress is an annotation set by the ACK service
+	// controller on CRs whose AWS resource was created asynchronously and
+	// could not be read yet. Its value is the RFC3339 time at which the AWS
+	// resource was created. While it is set, the controller keeps checking
+	// whether the AWS resource exists instead of creating it again. Remove
+	// the annotation to create the AWS resource again.
+	AnnotationCreateInProgress = AnnotationPrefix + "create-in-progress"
+	// AnnotationEstimatedMonthlyCost is an annotation set by the ACK service
+	// controller on CRs whose resource manager estimates the cost of AWS
+	// resources. Its value is the estimated monthly cost of the backend AWS
+	// resource in US dollars, e.g. "12.50", as of its latest creation or
+	// update.
+	AnnotationEstimatedMonthlyCost = AnnotationPrefix + "estimated-monthly-cost"
+	// AnnotationLabelTags is an annotation set by the ACK service controller
+	// on CRs whose labels are propagated as AWS tags with the
+	// --label-tag-keys flag. Its value is the comma-separated list of the keys
+	// of the tags propagated from labels, so that the tags are removed once
+	// the labels are.
+	AnnotationLabelTags = AnnotationPrefix + "label-tags"
+	// AnnotationPauseReconciliation is an annotation whose value is a boolean
+	// value. If this annotation is set to "true" on a CR, the ACK service
+	// controller stops reconciling the CR, leaving its AWS resource untouched,
+	// and sets an ACK.Paused condition on it. Reconciliation resumes once the
+	// annotation is removed. A paused CR that is deleted keeps its finalizer,
+	// and its AWS resource, until the annotation is removed.
+	AnnotationPauseReconciliation = AnnotationPrefix + "pause-reconciliation"
+	// AnnotationForceReconcile is an annotation whose value is an arbitrary
+	// string, typically a timestamp. Changing its value on a CR makes the ACK
+	// service controller reconcile the CR immediately, reading its AWS
+	// resource and correcting any drift, even though the CR's generation did
+	// not change. Changes to other annotations do not trigger reconciliations.
+	AnnotationForceReconcile = AnnotationPrefix + "force-reconcile"
 )
@@ -62,6 +62,35 @@ const (
 	// "False" status indicates that the resource references failed to resolve.
 	// For Ex: When referenced resource is in terminal condition
 	ConditionTypeReferencesResolved ConditionType = "ACK.ReferencesResolved"
+	// ConditionTypeReconcileSummary contains a concise, human-readable
+	// summary of the outcome of the latest reconciliation of the resource.
+	// The condition is only present when the service controller is started
+	// with the --enable-reconcile-summary-condition flag.
+	// "True" status indicates that the resource is synced.
+	// "False" status indicates that the resource is not synced.
+	// "Unknown" status indicates that the reconciliation failed.
+	ConditionTypeReconcileSummary ConditionType = "ACK.ReconcileSummary"
+	// ConditionTypeTagsApplied indicates whether the tags of the resource
+	// could be applied. The condition is only set when the service controller
+	// is started with the --tolerate-tag-failures flag and applying the tags
+	// failed.
+	// "False" status indicates that the tags could not be applied, while the
+	// rest of the resource was reconciled.
+	ConditionTypeTagsApplied ConditionType = "ACK.TagsApplied"
+	// ConditionTypeQuotaExceeded indicates that the AWS resource could not be
+	// created or updated because a service quota or limit of the AWS account
+	// was reached. The resource is retried after the interval set with the
+	// --quota-exceeded-requeue-seconds flag.
+	// "True" status indicates that the quota was exceeded during the latest
+	// reconciliation.
+	ConditionTypeQuotaExceeded ConditionType = "ACK.QuotaExceeded"
+	// ConditionTypePaused indicates that the reconciliation of the custom
+	// resource is suspended because it carries the
+	// services.k8s.aws/pause-reconciliation annotation set to "true". The
+	// controller does not read, update or delete the AWS resource until the
+	// annotation is removed.
+	// "True" status indicates that the reconciliation is paused.
+	ConditionTypePaused ConditionType = "ACK.Paused"
 )
 
 // Condition is the common struct used by all CRDs managed by ACK service
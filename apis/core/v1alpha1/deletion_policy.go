@@ -20,12 +20,19 @@ import (
 // DeletionPolicy represents how the ACK reconciler will handle the deletion of
 // a resource. A DeletionPolicy of "delete" will delete the underlying AWS
 // resource, whereas a DeletionPolicy of "retain" will only delete the K8s
-// object leaving the AWS resource intact.
+// object leaving the AWS resource intact. A DeletionPolicy of "soft-delete"
+// marks the underlying AWS resource as pending deletion and only deletes it
+// once a retention period has elapsed. A DeletionPolicy of "orphan" also
+// deletes the K8s object and leaves the AWS resource intact, expressing that
+// the AWS resource is deliberately handed over to another owner rather than
+// kept for later adoption.
 type DeletionPolicy string
 
 const (
-	DeletionPolicyDelete DeletionPolicy = "delete"
-	DeletionPolicyRetain DeletionPolicy = "retain"
+	DeletionPolicyDelete     DeletionPolicy = "delete"
+	DeletionPolicyRetain     DeletionPolicy = "retain"
+	DeletionPolicySoftDelete DeletionPolicy = "soft-delete"
+	DeletionPolicyOrphan     DeletionPolicy = "orphan"
 )
 
 func (e *DeletionPolicy) String() string {
@@ -34,7 +41,8 @@ func (e *DeletionPolicy) String() string {
 
 func (e *DeletionPolicy) Set(v string) error {
 	switch v {
-	case string(DeletionPolicyDelete), string(DeletionPolicyRetain):
+	case string(DeletionPolicyDelete), string(DeletionPolicyRetain), string(DeletionPolicySoftDelete),
+		string(DeletionPolicyOrphan):
 		*e = DeletionPolicy(v)
 		return nil
 	default:
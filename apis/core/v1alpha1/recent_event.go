@@ -0,0 +1,39 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RecentEvent records a change to the observed state of a backend AWS service
+// API resource, e.g. an RDS DBInstance moving from "modifying" to
+// "available", as observed by the ACK service controller between two
+// reconciliations of the CR. CRs that expose a `Status.ACKRecentEvents`
+// collection keep a bounded number of the most recent events, oldest first.
+type RecentEvent struct {
+	// Time is when the ACK service controller observed the change.
+	Time metav1.Time `json:"time"`
+	// Field is the path, in dotted JSON notation, of the Status field that
+	// changed, e.g. "status.dbInstanceStatus".
+	Field string `json:"field"`
+	// From is the value of the field before the change. Empty if the field
+	// was not set.
+	// +optional
+	From string `json:"from,omitempty"`
+	// To is the value of the field after the change. Empty if the field is no
+	// longer set.
+	// +optional
+	To string `json:"to,omitempty"`
+}
@@ -426,6 +426,22 @@ func (in *PartialObjectMeta) DeepCopy() *PartialObjectMeta {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RecentEvent) DeepCopyInto(out *RecentEvent) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RecentEvent.
+func (in *RecentEvent) DeepCopy() *RecentEvent {
+	if in == nil {
+		return nil
+	}
+	out := new(RecentEvent)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceFieldSelector) DeepCopyInto(out *ResourceFieldSelector) {
 	*out = *in
@@ -34,6 +34,52 @@ func (d *Delta) DifferentAt(subject string) bool {
 	return false
 }
 
+// ClearedAt returns the paths of the differences contained in the supplied
+// path strings for which the first compared resource has an empty value
+// while the second compared resource has a non-empty value. When comparing a
+// desired resource with the latest observed resource, these are the fields
+// that an update would clear.
+func (d *Delta) ClearedAt(subjects ...string) []string {
+	cleared := []string{}
+	for _, diff := range d.Differences {
+		if !IsEmpty(diff.A) || IsEmpty(diff.B) {
+			continue
+		}
+		for _, subject := range subjects {
+			if diff.Path.Contains(subject) {
+				cleared = append(cleared, diff.Path.String())
+				break
+			}
+		}
+	}
+	return cleared
+}
+
+// WithoutDefaultedAt returns a copy of the delta without the differences
+// contained in the supplied path strings for which the first compared
+// resource has an empty value while the second compared resource has a
+// non-empty value. When comparing a desired resource with the latest observed
+// resource, these are the fields left unset by the user to which the AWS
+// service applied a default value.
+func (d *Delta) WithoutDefaultedAt(subjects ...string) *Delta {
+	filtered := NewDelta()
+	for _, diff := range d.Differences {
+		defaulted := false
+		if IsEmpty(diff.A) && !IsEmpty(diff.B) {
+			for _, subject := range subjects {
+				if diff.Path.Contains(subject) {
+					defaulted = true
+					break
+				}
+			}
+		}
+		if !defaulted {
+			filtered.Differences = append(filtered.Differences, diff)
+		}
+	}
+	return filtered
+}
+
 // DifferentExcept returns true if the delta contains any differences *other*
 // than any of the supplied path strings.
 //
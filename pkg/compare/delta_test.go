@@ -90,3 +90,40 @@ func TestDifferentExcept(t *testing.T) {
 	require.True(d.DifferentExcept("Bar"))    // there is a difference that is *not* Bar
 	require.False(d.DifferentExcept("Baz.Y")) // there is *not* a different that is *not* Bar
 }
+
+func TestClearedAt(t *testing.T) {
+	require := require.New(t)
+
+	name := "my-name"
+	empty := ""
+	d := compare.NewDelta()
+	d.Add("Spec.Name", &empty, &name)
+	d.Add("Spec.Policy", nil, &name)
+	d.Add("Spec.Tags", []string{}, []string{"a"})
+	d.Add("Spec.Description", &name, nil)
+	d.Add("Spec.Size", int64(0), int64(0))
+
+	require.Equal(
+		[]string{"Spec.Name", "Spec.Policy"},
+		d.ClearedAt("Spec.Name", "Spec.Policy", "Spec.Description", "Spec.Size"),
+	)
+	require.Equal([]string{"Spec.Tags"}, d.ClearedAt("Spec.Tags"))
+	require.Empty(d.ClearedAt("Spec.Other"))
+}
+
+func TestWithoutDefaultedAt(t *testing.T) {
+	require := require.New(t)
+
+	name := "my-name"
+	d := compare.NewDelta()
+	d.Add("Spec.Name", nil, &name)
+	d.Add("Spec.Engine", nil, &name)
+	d.Add("Spec.Description", &name, nil)
+
+	filtered := d.WithoutDefaultedAt("Spec.Name", "Spec.Description")
+	require.False(filtered.DifferentAt("Spec.Name"))
+	require.True(filtered.DifferentAt("Spec.Engine"))
+	require.True(filtered.DifferentAt("Spec.Description"))
+	// The original delta is left untouched
+	require.True(d.DifferentAt("Spec.Name"))
+}
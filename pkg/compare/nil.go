@@ -47,3 +47,23 @@ func IsNil(i interface{}) bool {
 func IsNotNil(i interface{}) bool {
 	return !IsNil(i)
 }
+
+// IsEmpty returns true if the supplied subject is nil, a pointer to an empty
+// value, the zero value of its type, or an empty string, slice or map.
+func IsEmpty(i interface{}) bool {
+	if IsNil(i) {
+		return true
+	}
+	v := reflect.ValueOf(i)
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return true
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	}
+	return v.IsZero()
+}
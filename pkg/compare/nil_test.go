@@ -43,3 +43,25 @@ func TestNilDifference(t *testing.T) {
 	require.True(compare.HasNilDifference(nullChan, nonNullChan))
 
 }
+
+func TestIsEmpty(t *testing.T) {
+	require := require.New(t)
+
+	empty := ""
+	value := "value"
+	var zero int64
+	var nullPtr *string
+
+	require.True(compare.IsEmpty(nil))
+	require.True(compare.IsEmpty(nullPtr))
+	require.True(compare.IsEmpty(&empty))
+	require.True(compare.IsEmpty(&zero))
+	require.True(compare.IsEmpty([]string{}))
+	require.True(compare.IsEmpty(map[string]string{}))
+	require.True(compare.IsEmpty(false))
+
+	require.False(compare.IsEmpty(&value))
+	require.False(compare.IsEmpty(value))
+	require.False(compare.IsEmpty([]string{"a"}))
+	require.False(compare.IsEmpty(int64(1)))
+}
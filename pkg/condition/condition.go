@@ -32,6 +32,116 @@ var (
 	UnknownSyncedMessage = "Unable to determine if desired resource state matches latest observed state"
 	NotSyncedMessage     = "Resource not synced"
 	SyncedMessage        = "Resource synced successfully"
+	// UpdatePartiallyAppliedMessage is the message set on the ACK.Advisory
+	// condition when an update failed after only some of the desired changes
+	// were applied to the AWS resource.
+	UpdatePartiallyAppliedMessage = "Update partially applied"
+	// NameConflictMessage is the message set on the ACK.Terminal condition
+	// when a resource cannot be created because its name is already taken.
+	NameConflictMessage = "Resource name already in use"
+	// DestructiveUpdateNotConfirmedMessage is the message set on the
+	// ACK.Advisory condition when a destructive update is waiting for the
+	// user's confirmation.
+	DestructiveUpdateNotConfirmedMessage = "Destructive update requires confirmation"
+	// DeletionProtectedMessage is the message set on the ACK.Advisory
+	// condition when the deletion of a resource is blocked by the
+	// services.k8s.aws/deletion-protection annotation.
+	DeletionProtectedMessage = "Deletion protection enabled"
+	DeletionProtectedReason  = "The resource is being deleted but has the " +
+		"services.k8s.aws/deletion-protection annotation set to \"true\". " +
+		"Remove the annotation to delete the AWS resource"
+	// CreateInProgressReason is the reason of the ACK.ResourceSynced
+	// condition of resources whose AWS resource was created asynchronously
+	// and cannot be read yet.
+	CreateInProgressReason = "The AWS resource is being created"
+	// CreateBudgetExhaustedReason is the reason of the ACK.ResourceSynced
+	// condition of resources whose creation is deferred because the create
+	// budget of their kind is used up.
+	CreateBudgetExhaustedReason = "The AWS resource is not created because " +
+		"the controller manages as many resources of this kind as allowed by " +
+		"--create-budgets"
+	// SoftDeletePendingMessage is the message set on the ACK.Advisory
+	// condition of resources deleted with the "soft-delete" deletion policy
+	// whose AWS resource is not deleted yet.
+	SoftDeletePendingMessage = "AWS resource pending deletion"
+	// TagsNotAppliedMessage is the message set on the ACK.TagsApplied
+	// condition when the tags of a resource could not be applied.
+	TagsNotAppliedMessage = "Tags not applied"
+	// PolicyDeniedMessage is the message set on the ACK.Terminal condition
+	// when creating or updating a resource is denied by the policy endpoint.
+	PolicyDeniedMessage = "Denied by policy"
+	// AdoptionObservedMessage is the message set on the ACK.Advisory
+	// condition when the first reconciliation of an adopted resource only
+	// observed the AWS resource instead of updating it.
+	AdoptionObservedMessage = "Adopted resource observed"
+	AdoptionObservedReason  = "The Spec of the adopted resource differs from " +
+		"the observed state of the AWS resource. The AWS resource will be " +
+		"updated to match the Spec on the next reconciliation"
+	// UpdateScheduledMessage is the message set on the ACK.Advisory condition
+	// when the update of a resource is deferred until the time set in its
+	// services.k8s.aws/apply-after annotation.
+	UpdateScheduledMessage = "Update scheduled"
+	// InvalidApplyAfterMessage is the message set on the ACK.Terminal
+	// condition when the services.k8s.aws/apply-after annotation of a
+	// resource is not a valid RFC3339 timestamp.
+	InvalidApplyAfterMessage = "Invalid services.k8s.aws/apply-after annotation"
+	// ProtectedFieldsClearedMessage is the message set on the ACK.Advisory
+	// condition when an update clearing protected fields is not applied.
+	ProtectedFieldsClearedMessage = "Update clears protected fields"
+	// ReadOnlyModeWriteMessage is the message set on the ACK.Terminal
+	// condition when the reconciler attempts to modify an AWS resource
+	// reconciled in read-only mode.
+	ReadOnlyModeWriteMessage = "Write attempted in read-only mode"
+	// ReadOnlyModeNotFoundReason is the reason of the ACK.ResourceSynced
+	// condition of resources reconciled in read-only mode whose AWS resource
+	// does not exist.
+	ReadOnlyModeNotFoundReason = "The AWS resource does not exist and is " +
+		"not created in read-only mode"
+	// ExternallyDeletedMessage is the message set on the ACK.Terminal
+	// condition of resources whose AWS resource was deleted outside of the
+	// controller and is not created again.
+	ExternallyDeletedMessage = "The AWS resource was deleted outside of the controller"
+	// ExternallyDeletedReason is the reason set on the ACK.Terminal
+	// condition of resources whose AWS resource was deleted outside of the
+	// controller and is not created again.
+	ExternallyDeletedReason = "The resource is not created again because " +
+		"--on-external-deletion is 'terminal'. Delete and recreate the " +
+		"custom resource to create a new AWS resource"
+	// UnresolvedAccountMessage is the message set on the ACK.Terminal
+	// condition of resources for which no AWS account could be resolved.
+	UnresolvedAccountMessage = "No AWS account could be resolved for this resource"
+	// UnresolvedAccountReason is the reason set on the ACK.Terminal
+	// condition of resources for which no AWS account could be resolved.
+	UnresolvedAccountReason = "Annotate the resource's namespace with " +
+		"services.k8s.aws/owner-account-id or configure the controller " +
+		"with a default AWS account"
+	// QuotaExceededMessage is the message set on the ACK.QuotaExceeded
+	// condition when a service quota or limit of the AWS account was reached.
+	QuotaExceededMessage = "AWS service quota exceeded"
+	// PausedMessage is the message set on the ACK.Paused condition of
+	// resources whose reconciliation is paused.
+	PausedMessage = "Reconciliation is paused"
+	// PausedReason is the reason set on the ACK.Paused condition of
+	// resources whose reconciliation is paused.
+	PausedReason = "The resource has the " +
+		"services.k8s.aws/pause-reconciliation annotation set to \"true\". " +
+		"Remove the annotation to resume reconciliation"
+	// AdoptedResourceNotFoundReason is the reason of the ACK.ResourceSynced
+	// condition of adopted resources whose AWS resource does not exist yet,
+	// when the reconciler is configured to wait for it.
+	AdoptedResourceNotFoundReason = "Waiting for the AWS resource to adopt " +
+		"to exist"
+	// MissingSecretMessage is the message of the ACK.ReferencesResolved
+	// condition of resources waiting for a Secret they refer to to exist.
+	MissingSecretMessage = "Waiting for the referenced Secret to exist"
+	// RegionConflictMessage is the message set on the ACK.Terminal condition
+	// of resources whose region annotation was changed after their AWS
+	// resource was created.
+	RegionConflictMessage = "Region annotation conflicts with the region " +
+		"where this resource was created"
+	// PanicRecoveredMessage is the message set on the ACK.Recoverable
+	// condition of resources whose reconciliation panicked.
+	PanicRecoveredMessage = "Reconciliation panicked and will be retried"
 )
 
 // Synced returns the Condition in the resource's Conditions collection that is
@@ -55,6 +165,13 @@ func Recoverable(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
 	return FirstOfType(subject, ackv1alpha1.ConditionTypeRecoverable)
 }
 
+// Advisory returns the Condition in the resource's Conditions collection that
+// is of type ConditionTypeAdvisory. If no such condition is found, returns
+// nil.
+func Advisory(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return FirstOfType(subject, ackv1alpha1.ConditionTypeAdvisory)
+}
+
 // LateInitialized returns the Condition in the resource's Conditions collection that
 // is of type ConditionTypeLateInitialized. If no such condition is found, returns
 // nil.
@@ -69,6 +186,33 @@ func ReferencesResolved(subject acktypes.ConditionManager) *ackv1alpha1.Conditio
 	return FirstOfType(subject, ackv1alpha1.ConditionTypeReferencesResolved)
 }
 
+// TagsApplied returns the Condition in the resource's Conditions collection
+// that is of type ConditionTypeTagsApplied. If no such condition is found,
+// returns nil.
+func TagsApplied(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return FirstOfType(subject, ackv1alpha1.ConditionTypeTagsApplied)
+}
+
+// QuotaExceeded returns the Condition in the resource's Conditions collection
+// that is of type ConditionTypeQuotaExceeded. If no such condition is found,
+// returns nil.
+func QuotaExceeded(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return FirstOfType(subject, ackv1alpha1.ConditionTypeQuotaExceeded)
+}
+
+// Paused returns the Condition in the resource's Conditions collection that
+// is of type ConditionTypePaused. If no such condition is found, returns nil.
+func Paused(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return FirstOfType(subject, ackv1alpha1.ConditionTypePaused)
+}
+
+// ReconcileSummary returns the Condition in the resource's Conditions
+// collection that is of type ConditionTypeReconcileSummary. If no such
+// condition is found, returns nil.
+func ReconcileSummary(subject acktypes.ConditionManager) *ackv1alpha1.Condition {
+	return FirstOfType(subject, ackv1alpha1.ConditionTypeReconcileSummary)
+}
+
 // FirstOfType returns the first Condition in the resource's Conditions
 // collection of the supplied type. If no such condition is found, returns nil.
 func FirstOfType(
@@ -170,6 +314,30 @@ func SetRecoverable(
 	subject.ReplaceConditions(allConds)
 }
 
+// SetAdvisory sets the resource's Condition of type ConditionTypeAdvisory to
+// the supplied status, optional message and reason.
+func SetAdvisory(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	message *string,
+	reason *string,
+) {
+	allConds := subject.Conditions()
+	var c *ackv1alpha1.Condition
+	if c = Advisory(subject); c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ackv1alpha1.ConditionTypeAdvisory,
+		}
+		allConds = append(allConds, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = message
+	c.Reason = reason
+	subject.ReplaceConditions(allConds)
+}
+
 // SetLateInitialized sets the resource's Condition of type ConditionTypeLateInitialized to
 // the supplied status, optional message and reason.
 func SetLateInitialized(
@@ -218,6 +386,103 @@ func SetReferencesResolved(
 	subject.ReplaceConditions(allConds)
 }
 
+// SetTagsApplied sets the resource's Condition of type
+// ConditionTypeTagsApplied to the supplied status, optional message and
+// reason.
+func SetTagsApplied(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	message *string,
+	reason *string,
+) {
+	allConds := subject.Conditions()
+	var c *ackv1alpha1.Condition
+	if c = TagsApplied(subject); c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ackv1alpha1.ConditionTypeTagsApplied,
+		}
+		allConds = append(allConds, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = message
+	c.Reason = reason
+	subject.ReplaceConditions(allConds)
+}
+
+// SetQuotaExceeded sets the resource's Condition of type
+// ConditionTypeQuotaExceeded to the supplied status, optional message and
+// reason.
+func SetQuotaExceeded(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	message *string,
+	reason *string,
+) {
+	allConds := subject.Conditions()
+	var c *ackv1alpha1.Condition
+	if c = QuotaExceeded(subject); c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ackv1alpha1.ConditionTypeQuotaExceeded,
+		}
+		allConds = append(allConds, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = message
+	c.Reason = reason
+	subject.ReplaceConditions(allConds)
+}
+
+// SetPaused sets the resource's Condition of type ConditionTypePaused to the
+// supplied status, optional message and reason.
+func SetPaused(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	message *string,
+	reason *string,
+) {
+	allConds := subject.Conditions()
+	var c *ackv1alpha1.Condition
+	if c = Paused(subject); c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ackv1alpha1.ConditionTypePaused,
+		}
+		allConds = append(allConds, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = message
+	c.Reason = reason
+	subject.ReplaceConditions(allConds)
+}
+
+// SetReconcileSummary sets the resource's Condition of type
+// ConditionTypeReconcileSummary to the supplied status and summary message.
+func SetReconcileSummary(
+	subject acktypes.ConditionManager,
+	status corev1.ConditionStatus,
+	summary string,
+) {
+	allConds := subject.Conditions()
+	var c *ackv1alpha1.Condition
+	if c = ReconcileSummary(subject); c == nil {
+		c = &ackv1alpha1.Condition{
+			Type: ackv1alpha1.ConditionTypeReconcileSummary,
+		}
+		allConds = append(allConds, c)
+	}
+	now := metav1.Now()
+	c.LastTransitionTime = &now
+	c.Status = status
+	c.Message = &summary
+	c.Reason = nil
+	subject.ReplaceConditions(allConds)
+}
+
 // RemoveReferencesResolved removes the condition of type ConditionTypeReferencesResolved
 // from the resource's conditions
 func RemoveReferencesResolved(
@@ -17,6 +17,8 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -26,31 +28,102 @@ import (
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/jaypipes/envutil"
 	flag "github.com/spf13/pflag"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap/zapcore"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
 	ctrlrt "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	"github.com/aws-controllers-k8s/runtime/pkg/encryption"
 	acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
 )
 
 const (
-	flagEnableLeaderElection           = "enable-leader-election"
-	flagMetricAddr                     = "metrics-addr"
-	flagEnableDevLogging               = "enable-development-logging"
-	flagAWSRegion                      = "aws-region"
-	flagAWSEndpointURL                 = "aws-endpoint-url"
-	flagAWSIdentityEndpointURL         = "aws-identity-endpoint-url"
-	flagUnsafeAWSEndpointURLs          = "allow-unsafe-aws-endpoint-urls"
-	flagLogLevel                       = "log-level"
-	flagResourceTags                   = "resource-tags"
-	flagWatchNamespace                 = "watch-namespace"
-	flagEnableWebhookServer            = "enable-webhook-server"
-	flagWebhookServerAddr              = "webhook-server-addr"
-	flagDeletionPolicy                 = "deletion-policy"
-	flagReconcileDefaultResyncSeconds  = "reconcile-default-resync-seconds"
-	flagReconcileResourceResyncSeconds = "reconcile-resource-resync-seconds"
-	envVarAWSRegion                    = "AWS_REGION"
+	flagEnableLeaderElection            = "enable-leader-election"
+	flagMetricAddr                      = "metrics-addr"
+	flagEnableDevLogging                = "enable-development-logging"
+	flagAWSRegion                       = "aws-region"
+	flagAWSEndpointURL                  = "aws-endpoint-url"
+	flagAWSIdentityEndpointURL          = "aws-identity-endpoint-url"
+	flagUnsafeAWSEndpointURLs           = "allow-unsafe-aws-endpoint-urls"
+	flagLogLevel                        = "log-level"
+	flagResourceTags                    = "resource-tags"
+	flagWatchNamespace                  = "watch-namespace"
+	flagEnableWebhookServer             = "enable-webhook-server"
+	flagWebhookServerAddr               = "webhook-server-addr"
+	flagDeletionPolicy                  = "deletion-policy"
+	flagReconcileDefaultResyncSeconds   = "reconcile-default-resync-seconds"
+	flagReconcileResourceResyncSeconds  = "reconcile-resource-resync-seconds"
+	flagRequeueOnSuccessOverrides       = "requeue-on-success-seconds"
+	flagReconcileReadAfterUpdateFailure = "reconcile-read-after-update-failure"
+	flagCanaryAnnotationKey             = "canary-annotation-key"
+	flagCanaryAnnotationValue           = "canary-annotation-value"
+	flagResourceNameTemplate            = "resource-name-template"
+	flagEnableReferenceCycleDetection   = "enable-reference-cycle-detection"
+	flagEnableReconcileSummary          = "enable-reconcile-summary-condition"
+	flagOrphanedResourcesConfigMap      = "orphaned-resources-configmap"
+	flagServiceMaxConcurrentReconciles  = "service-max-concurrent-reconciles"
+	flagDefaultServiceMaxConcurrency    = "default-service-max-concurrent-reconciles"
+	flagBackpressurePatchLatency        = "backpressure-patch-latency-threshold-ms"
+	flagAWSErrorSeverities              = "aws-error-severity"
+	flagEnableReconcileCountAnnotations = "enable-reconcile-count-annotations"
+	flagRequiredAnnotations             = "required-annotations"
+	flagEnforceRequiredAnnotations      = "enforce-required-annotations"
+	flagRecentEventsLimit               = "recent-events-limit"
+	flagTolerateTagFailures             = "tolerate-tag-failures"
+	flagPolicyEndpointURL               = "policy-endpoint-url"
+	flagPolicyFailOpen                  = "policy-fail-open"
+	flagPolicyTimeoutSeconds            = "policy-timeout-seconds"
+	flagMaxReferenceReads               = "max-reference-reads-per-reconcile"
+	flagEnableBackoffPersistence        = "enable-backoff-persistence"
+	flagAdoptionObserveFirst            = "adoption-observe-first"
+	flagResourceLoggerFields            = "resource-logger-fields"
+	flagEnforceSameLocationReferences   = "enforce-same-location-references"
+	flagReadOnlyMode                    = "read-only"
+	flagEnableResyncCoalescing          = "enable-resync-coalescing"
+	flagProtectedClearedFields          = "protected-cleared-fields"
+	flagClearedFieldsPolicy             = "cleared-fields-policy"
+	flagConditionTransitionLogSize      = "condition-transition-log-size"
+	flagPersistConditionTransitions     = "persist-condition-transitions"
+	flagUnresolvedAccountRequeueSeconds = "unresolved-account-requeue-seconds"
+	flagSessionCacheTTLSeconds          = "session-cache-ttl-seconds"
+	flagQuotaExceededRequeueSeconds     = "quota-exceeded-requeue-seconds"
+	flagRequeueAdoptedResourceNotFound  = "requeue-adopted-resource-not-found"
+	flagAdoptedNotFoundRequeueSeconds   = "adopted-resource-not-found-requeue-seconds"
+	flagEncryptedStatusFields           = "encrypted-status-fields"
+	flagStatusEncryptionKeyFile         = "status-encryption-key-file"
+	flagIgnoreRegionConflicts           = "ignore-region-conflicts"
+	flagDebugServerAddr                 = "debug-server-addr"
+	flagReferenceWatchKinds             = "reference-watch-kinds"
+	flagUnwatchedReferenceResync        = "unwatched-reference-resync-seconds"
+	flagOnExternalDeletion              = "on-external-deletion"
+	flagSkipInitialReadKinds            = "skip-initial-read-kinds"
+	flagResourceNamePatterns            = "resource-name-patterns"
+	flagSoftDeleteRetentionSeconds      = "soft-delete-retention-seconds"
+	flagEnableMetricsInstanceLabel      = "enable-metrics-instance-label"
+	flagCreateBudgets                   = "create-budgets"
+	flagAsyncCreatePollKinds            = "async-create-poll-kinds"
+	flagRequiredFieldValues             = "required-field-values"
+	flagRequiredFieldsPolicy            = "required-fields-policy"
+	flagAsyncCreatePollSeconds          = "async-create-poll-seconds"
+	flagEnableMetadataHousekeeping      = "enable-metadata-housekeeping"
+	flagWatchReferencedSecrets          = "watch-referenced-secrets"
+	flagAccessDeniedThreshold           = "access-denied-threshold"
+	flagAccessDeniedWindowSeconds       = "access-denied-window-seconds"
+	flagAccessDeniedPauseSeconds        = "access-denied-pause-seconds"
+	flagLabelTagKeys                    = "label-tag-keys"
+	flagOnMissingSecret                 = "on-missing-secret"
+	flagMissingSecretRequeueSeconds     = "missing-secret-requeue-seconds"
+	flagResyncJitterFactor              = "resync-jitter-factor"
+	flagFinalizerOverride               = "finalizer-override"
+	flagMaxConcurrentReconciles         = "max-concurrent-reconciles"
+	flagResourceMaxConcurrentReconciles = "resource-max-concurrent-reconciles"
+	flagResourceRateLimits              = "resource-rate-limits"
+	flagInstanceIdentity                = "instance-identity"
+	envVarAWSRegion                     = "AWS_REGION"
+	envVarPodName                       = "POD_NAME"
 )
 
 var (
@@ -64,26 +137,185 @@ var (
 		),
 	}
 	defaultLogLevel = zapcore.InfoLevel
+	// regionPattern matches the names of AWS regions, e.g. "us-west-2",
+	// "us-gov-east-1" or "cn-north-1"
+	regionPattern = regexp.MustCompile(`^[a-z]{2}(-[a-z]+)+-[0-9]+$`)
 )
 
+const (
+	// AWSErrorSeverityTerminal causes the resource to be placed in a Terminal
+	// condition when the AWS error is returned.
+	AWSErrorSeverityTerminal = "terminal"
+	// AWSErrorSeverityRecoverable causes the reconciliation to be retried with
+	// exponential backoff when the AWS error is returned.
+	AWSErrorSeverityRecoverable = "recoverable"
+	// AWSErrorSeverityRequeueAfter causes the resource to be requeued after a
+	// fixed duration when the AWS error is returned.
+	AWSErrorSeverityRequeueAfter = "requeue-after"
+)
+
+// AWSErrorSeverity describes how the reconciler handles an AWS error code.
+type AWSErrorSeverity struct {
+	// Kind is one of AWSErrorSeverityTerminal, AWSErrorSeverityRecoverable or
+	// AWSErrorSeverityRequeueAfter
+	Kind string
+	// RequeueAfter is the duration after which the resource is requeued when
+	// Kind is AWSErrorSeverityRequeueAfter
+	RequeueAfter time.Duration
+}
+
+const (
+	// ResourceLoggerFieldSourceLabel indicates that the value of a resource
+	// logger field is read from a label of the resource
+	ResourceLoggerFieldSourceLabel = "label"
+	// ResourceLoggerFieldSourceAnnotation indicates that the value of a
+	// resource logger field is read from an annotation of the resource
+	ResourceLoggerFieldSourceAnnotation = "annotation"
+)
+
+const (
+	// ClearedFieldsPolicyConfirm defers updates clearing protected fields
+	// until they are confirmed with the
+	// services.k8s.aws/confirm-destructive-update annotation
+	ClearedFieldsPolicyConfirm = "confirm"
+	// ClearedFieldsPolicyIgnore never applies updates clearing protected
+	// fields
+	ClearedFieldsPolicyIgnore = "ignore"
+)
+
+const (
+	// RequiredFieldsPolicyReport sets resources whose AWS resource does not
+	// have the required field values terminal
+	RequiredFieldsPolicyReport = "report"
+	// RequiredFieldsPolicyEnforce updates AWS resources that do not have the
+	// required Spec field values, and reports the other violations
+	RequiredFieldsPolicyEnforce = "enforce"
+)
+
+const (
+	// OnExternalDeletionRecreate creates the AWS resource again when it was
+	// deleted outside of the controller
+	OnExternalDeletionRecreate = "recreate"
+	// OnExternalDeletionTerminal sets the resource terminal instead of
+	// creating its AWS resource again when it was deleted outside of the
+	// controller
+	OnExternalDeletionTerminal = "terminal"
+)
+
+const (
+	// OnMissingSecretWait requeues resources referring to a Secret or Secret
+	// key that does not exist yet, waiting for it to be created
+	OnMissingSecretWait = "wait"
+	// OnMissingSecretFail fails the reconciliation of resources referring to
+	// a Secret or Secret key that does not exist
+	OnMissingSecretFail = "fail"
+)
+
+// ResourceLoggerField describes a field added to the log lines written while
+// reconciling a resource, whose value is read from a label or an annotation
+// of the resource.
+type ResourceLoggerField struct {
+	// Source is one of ResourceLoggerFieldSourceLabel or
+	// ResourceLoggerFieldSourceAnnotation
+	Source string
+	// Key is the key of the label or annotation
+	Key string
+	// Name is the name of the log field
+	Name string
+}
+
+// DefaultResourceNameTemplate is the template used to compute the name of
+// resources whose name was omitted by the Kubernetes user.
+const DefaultResourceNameTemplate = "%K8S_NAMESPACE%-%K8S_RESOURCE_NAME%-%K8S_RESOURCE_SHORT_UID%"
+
 // Config contains configuration options for ACK service controllers
 type Config struct {
-	MetricsAddr                    string
-	EnableLeaderElection           bool
-	EnableDevelopmentLogging       bool
-	AccountID                      string
-	Region                         string
-	IdentityEndpointURL            string
-	EndpointURL                    string
-	AllowUnsafeEndpointURL         bool
-	LogLevel                       string
-	ResourceTags                   []string
-	WatchNamespace                 string
-	EnableWebhookServer            bool
-	WebhookServerAddr              string
-	DeletionPolicy                 ackv1alpha1.DeletionPolicy
-	ReconcileDefaultResyncSeconds  int
-	ReconcileResourceResyncSeconds []string
+	MetricsAddr                     string
+	EnableLeaderElection            bool
+	EnableDevelopmentLogging        bool
+	AccountID                       string
+	Region                          string
+	IdentityEndpointURL             string
+	EndpointURL                     string
+	AllowUnsafeEndpointURL          bool
+	LogLevel                        string
+	ResourceTags                    []string
+	WatchNamespace                  string
+	EnableWebhookServer             bool
+	WebhookServerAddr               string
+	DeletionPolicy                  ackv1alpha1.DeletionPolicy
+	ReconcileDefaultResyncSeconds   int
+	ReconcileResourceResyncSeconds  []string
+	RequeueOnSuccessOverrides       []string
+	ReconcileReadAfterUpdateFailure bool
+	CanaryAnnotationKey             string
+	CanaryAnnotationValue           string
+	ResourceNameTemplate            string
+	EnableReferenceCycleDetection   bool
+	EnableReconcileSummary          bool
+	OrphanedResourcesConfigMap      string
+	ServiceMaxConcurrentReconciles  []string
+	DefaultServiceMaxConcurrency    int
+	BackpressurePatchLatencyMs      int
+	AWSErrorSeverities              []string
+	EnableReconcileCountAnnotations bool
+	RequiredAnnotations             []string
+	EnforceRequiredAnnotations      bool
+	RecentEventsLimit               int
+	TolerateTagFailures             bool
+	PolicyEndpointURL               string
+	PolicyFailOpen                  bool
+	PolicyTimeoutSeconds            int
+	MaxReferenceReads               int
+	EnableBackoffPersistence        bool
+	AdoptionObserveFirst            bool
+	ResourceLoggerFields            []string
+	EnforceSameLocationReferences   bool
+	ReadOnlyMode                    bool
+	EnableResyncCoalescing          bool
+	ProtectedClearedFields          []string
+	ClearedFieldsPolicy             string
+	ConditionTransitionLogSize      int
+	PersistConditionTransitions     bool
+	UnresolvedAccountRequeueSeconds int
+	SessionCacheTTLSeconds          int
+	QuotaExceededRequeueSeconds     int
+	RequeueAdoptedResourceNotFound  bool
+	AdoptedNotFoundRequeueSeconds   int
+	EncryptedStatusFields           []string
+	StatusEncryptionKeyFile         string
+	IgnoreRegionConflicts           bool
+	DebugServerAddr                 string
+	ReferenceWatchKinds             []string
+	UnwatchedReferenceResyncSeconds int
+	OnExternalDeletion              string
+	SkipInitialReadKinds            []string
+	ResourceNamePatterns            []string
+	SoftDeleteRetentionSeconds      int
+	EnableMetricsInstanceLabel      bool
+	InstanceIdentity                string
+	CreateBudgets                   []string
+	AsyncCreatePollKinds            []string
+	AsyncCreatePollSeconds          int
+	RequiredFieldValues             []string
+	RequiredFieldsPolicy            string
+	EnableMetadataHousekeeping      bool
+	WatchReferencedSecrets          bool
+	AccessDeniedThreshold           int
+	AccessDeniedWindowSeconds       int
+	AccessDeniedPauseSeconds        int
+	LabelTagKeys                    []string
+	OnMissingSecret                 string
+	MissingSecretRequeueSeconds     int
+	ResyncJitterFactor              float64
+	FinalizerOverride               string
+	MaxConcurrentReconciles         int
+	ResourceMaxConcurrentReconciles []string
+	ResourceRateLimits              []string
+	// TracerProvider provides the tracer of the spans recorded around
+	// reconciliations. It is not bound to a flag: controllers exporting
+	// traces set it before starting the reconcilers. Nil disables tracing.
+	TracerProvider trace.TracerProvider
 }
 
 // BindFlags defines CLI/runtime configuration options
@@ -172,6 +404,447 @@ func (cfg *Config) BindFlags() {
 			" configuration maps resource kinds to drift remediation periods in seconds. If provided, "+
 			" resource-specific resync periods take precedence over the default period.",
 	)
+	flag.StringArrayVar(
+		&cfg.RequeueOnSuccessOverrides, flagRequeueOnSuccessOverrides,
+		[]string{},
+		"A Key/Value list of strings mapping resource kinds to the number of seconds after which resources "+
+			"are requeued once successfully synced, e.g. 'bucket=600'. If provided, these periods take "+
+			"precedence over the requeue on success periods built into the controller.",
+	)
+	flag.BoolVar(
+		&cfg.ReconcileReadAfterUpdateFailure, flagReconcileReadAfterUpdateFailure,
+		false,
+		"When an update of an AWS resource fails, re-read the resource from the AWS API and record what was "+
+			"actually applied in the resource status along with an ACK.Advisory condition.",
+	)
+	flag.StringVar(
+		&cfg.CanaryAnnotationKey, flagCanaryAnnotationKey,
+		"",
+		"When set, the service controller will only reconcile resources that have this annotation set to the "+
+			"value of --canary-annotation-value. All other resources are ignored, which allows a canary version "+
+			"of the controller to run alongside a stable version.",
+	)
+	flag.StringVar(
+		&cfg.CanaryAnnotationValue, flagCanaryAnnotationValue,
+		"",
+		"The value of the --canary-annotation-key annotation that resources must have in order to be "+
+			"reconciled by the service controller. When empty, any value of the annotation matches.",
+	)
+	flag.StringVar(
+		&cfg.ResourceNameTemplate, flagResourceNameTemplate,
+		DefaultResourceNameTemplate,
+		"The template used to compute the name of resources that support it when the name is omitted from the "+
+			"resource Spec. Supported formats are %K8S_NAMESPACE%, %K8S_RESOURCE_NAME%, %K8S_RESOURCE_UID% and "+
+			"%K8S_RESOURCE_SHORT_UID%.",
+	)
+	flag.BoolVar(
+		&cfg.EnableReferenceCycleDetection, flagEnableReferenceCycleDetection,
+		true,
+		"Detect resources that directly or indirectly reference themselves and set an ACK.Terminal condition "+
+			"naming the reference cycle instead of waiting for the references to resolve.",
+	)
+	flag.BoolVar(
+		&cfg.EnableReconcileSummary, flagEnableReconcileSummary,
+		false,
+		"Set an ACK.ReconcileSummary condition containing a one-line summary of the latest reconciliation "+
+			"on every resource. The condition is updated on every reconciliation.",
+	)
+	flag.StringVar(
+		&cfg.OrphanedResourcesConfigMap, flagOrphanedResourcesConfigMap,
+		"",
+		"The ConfigMap, in the format '<namespace>/<name>', in which the identifiers of AWS resources are "+
+			"recorded when the ACK finalizer is removed from their resource before the AWS resource was deleted. "+
+			"When empty, such resources are only logged and counted in metrics.",
+	)
+	flag.StringArrayVar(
+		&cfg.ServiceMaxConcurrentReconciles, flagServiceMaxConcurrentReconciles,
+		[]string{},
+		"A Key/Value list of strings mapping AWS service aliases to the maximum number of reconciliations of "+
+			"that service's resources that may run concurrently, e.g. 'ec2=5'. The limit is shared by all the "+
+			"reconcilers of the service, keeping the controller within the service's API rate quotas.",
+	)
+	flag.IntVar(
+		&cfg.DefaultServiceMaxConcurrency, flagDefaultServiceMaxConcurrency,
+		0,
+		"The maximum number of reconciliations of a service's resources that may run concurrently when the "+
+			"service is not listed in --service-max-concurrent-reconciles. 0 means no limit.",
+	)
+	flag.IntVar(
+		&cfg.BackpressurePatchLatencyMs, flagBackpressurePatchLatency,
+		1000,
+		"The average latency, in milliseconds, of the patches made to the Kubernetes API server above which "+
+			"requeue intervals are lengthened until the latency recovers. 0 disables backpressure.",
+	)
+	flag.StringArrayVar(
+		&cfg.AWSErrorSeverities, flagAWSErrorSeverities,
+		[]string{},
+		"A Key/Value list of strings mapping AWS error codes to the severity with which they are handled, "+
+			"overriding the built-in handling. Severities are 'terminal', 'recoverable' or "+
+			"'requeue-after:<seconds>', e.g. 'LimitExceededException=requeue-after:300'.",
+	)
+	flag.BoolVar(
+		&cfg.EnableReconcileCountAnnotations, flagEnableReconcileCountAnnotations,
+		false,
+		"Maintain the services.k8s.aws/reconcile-count and services.k8s.aws/reconcile-error-count annotations "+
+			"on resources that are not synced yet. Updating the annotations requires an additional patch per "+
+			"reconciliation.",
+	)
+	flag.StringSliceVar(
+		&cfg.RequiredAnnotations, flagRequiredAnnotations,
+		[]string{},
+		"The annotation keys that every resource must carry, e.g. 'example.com/cost-center'. Resources missing "+
+			"any of these annotations are not created or updated in AWS.",
+	)
+	flag.BoolVar(
+		&cfg.EnforceRequiredAnnotations, flagEnforceRequiredAnnotations,
+		true,
+		"Place resources missing any of the --required-annotations in a terminal condition. When false, "+
+			"missing annotations are only logged.",
+	)
+	flag.IntVar(
+		&cfg.RecentEventsLimit, flagRecentEventsLimit,
+		10,
+		"The maximum number of observed changes to the AWS resource state kept in the Status.ACKRecentEvents "+
+			"collection of resources exposing it. 0 disables the collection of recent events.",
+	)
+	flag.BoolVar(
+		&cfg.TolerateTagFailures, flagTolerateTagFailures,
+		false,
+		"Continue reconciling resources whose tags cannot be applied, setting an ACK.TagsApplied condition "+
+			"with a False status instead of failing the reconciliation.",
+	)
+	flag.StringVar(
+		&cfg.PolicyEndpointURL, flagPolicyEndpointURL,
+		"",
+		"The URL of an Open Policy Agent (or compatible webhook) endpoint consulted before creating or updating "+
+			"AWS resources, e.g. 'http://opa:8181/v1/data/ack/allow'. Resources denied by the policies are placed "+
+			"in a terminal condition. When empty, no policy is enforced.",
+	)
+	flag.BoolVar(
+		&cfg.PolicyFailOpen, flagPolicyFailOpen,
+		false,
+		"Allow creating and updating AWS resources when the policy endpoint cannot be reached. By default, "+
+			"mutations are retried until the policy endpoint returns a decision.",
+	)
+	flag.IntVar(
+		&cfg.PolicyTimeoutSeconds, flagPolicyTimeoutSeconds,
+		10,
+		"The timeout, in seconds, of the requests made to the policy endpoint.",
+	)
+	flag.IntVar(
+		&cfg.MaxReferenceReads, flagMaxReferenceReads,
+		1000,
+		"The maximum number of reads of referenced resources a single reconciliation may make to the "+
+			"Kubernetes API server while resolving references. Resources exceeding the limit are placed in a "+
+			"terminal condition. 0 means no limit.",
+	)
+	flag.BoolVar(
+		&cfg.EnableBackoffPersistence, flagEnableBackoffPersistence,
+		false,
+		"Persist the number of consecutive failed reconciliations and the resulting backoff of resources in "+
+			"the services.k8s.aws/backoff-state annotation, so that a restarted controller resumes the backoff "+
+			"of failing resources instead of retrying all of them at once.",
+	)
+	flag.BoolVar(
+		&cfg.AdoptionObserveFirst, flagAdoptionObserveFirst,
+		false,
+		"Only observe adopted resources on their first reconciliation, without updating the AWS resource to "+
+			"match the Spec. The Spec is enforced from the next reconciliation on.",
+	)
+	flag.StringArrayVar(
+		&cfg.ResourceLoggerFields, flagResourceLoggerFields,
+		[]string{},
+		"Labels or annotations of resources whose values are added as fields to the log lines written while "+
+			"reconciling the resources, in the format 'label:key=field' or 'annotation:key=field', e.g. "+
+			"'label:example.com/business-unit=business_unit'.",
+	)
+	flag.BoolVar(
+		&cfg.EnforceSameLocationReferences, flagEnforceSameLocationReferences,
+		false,
+		"Place resources referencing resources in a different AWS account or region in a terminal condition "+
+			"instead of resolving the references.",
+	)
+	flag.BoolVar(
+		&cfg.ReadOnlyMode, flagReadOnlyMode,
+		false,
+		"Only read AWS resources and report differences with the desired state in the resources' conditions, "+
+			"without ever creating, updating or deleting AWS resources. Allows running the controller with a "+
+			"read-only IAM role.",
+	)
+	flag.BoolVar(
+		&cfg.EnableResyncCoalescing, flagEnableResyncCoalescing,
+		false,
+		"Skip the resync of resources whose current generation was already successfully reconciled since the "+
+			"resync was scheduled, avoiding back-to-back reconciliations of actively changing resources.",
+	)
+	flag.StringArrayVar(
+		&cfg.ProtectedClearedFields, flagProtectedClearedFields,
+		[]string{},
+		"A Key/Value list of strings mapping resource kinds to the paths of Spec fields that must not be "+
+			"cleared without confirmation, e.g. 'bucket=Spec.Policy'. See --cleared-fields-policy.",
+	)
+	flag.StringVar(
+		&cfg.ClearedFieldsPolicy, flagClearedFieldsPolicy,
+		ClearedFieldsPolicyConfirm,
+		"How updates clearing one of the --protected-cleared-fields are handled. With 'confirm', the update "+
+			"is applied once the services.k8s.aws/confirm-destructive-update annotation is set to \"true\". "+
+			"With 'ignore', the update is never applied.",
+	)
+	flag.IntVar(
+		&cfg.ConditionTransitionLogSize, flagConditionTransitionLogSize,
+		0,
+		"The number of condition status transitions kept in memory for each resource, to help debugging "+
+			"resources flapping between conditions. 0 disables the transition log.",
+	)
+	flag.BoolVar(
+		&cfg.PersistConditionTransitions, flagPersistConditionTransitions,
+		false,
+		"Persist the condition transition log of each resource in its "+
+			"services.k8s.aws/condition-transitions annotation. Has no effect unless "+
+			"--condition-transition-log-size is set.",
+	)
+	flag.IntVar(
+		&cfg.UnresolvedAccountRequeueSeconds, flagUnresolvedAccountRequeueSeconds,
+		0,
+		"The number of seconds after which resources for which no AWS account could be resolved are "+
+			"reconciled again, e.g. to pick up an owner account ID annotation added to their namespace. "+
+			"0 means such resources are only reconciled again when they are modified.",
+	)
+	flag.IntVar(
+		&cfg.SessionCacheTTLSeconds, flagSessionCacheTTLSeconds,
+		900,
+		"The number of seconds an AWS session is reused by the reconciliations of resources sharing the "+
+			"same account, region, role and endpoint. Sessions are discarded early when their credentials "+
+			"expire or are about to expire. 0 disables session caching.",
+	)
+	flag.IntVar(
+		&cfg.QuotaExceededRequeueSeconds, flagQuotaExceededRequeueSeconds,
+		3600,
+		"The number of seconds after which resources that failed to reconcile because a service quota or "+
+			"limit of the AWS account was reached are retried. Such resources are placed in an "+
+			"ACK.QuotaExceeded condition. 0 retries them like any other failed reconciliation.",
+	)
+	flag.BoolVar(
+		&cfg.RequeueAdoptedResourceNotFound, flagRequeueAdoptedResourceNotFound,
+		false,
+		"Requeue adopted resources whose AWS resource does not exist yet after "+
+			"--adopted-resource-not-found-requeue-seconds, instead of failing their reconciliation. Allows "+
+			"adopting AWS resources that are being created concurrently by another process.",
+	)
+	flag.IntVar(
+		&cfg.AdoptedNotFoundRequeueSeconds, flagAdoptedNotFoundRequeueSeconds,
+		60,
+		"The number of seconds after which adopted resources whose AWS resource does not exist yet are "+
+			"reconciled again, when --requeue-adopted-resource-not-found is set.",
+	)
+	flag.StringArrayVar(
+		&cfg.EncryptedStatusFields, flagEncryptedStatusFields,
+		[]string{},
+		"A Key/Value list of strings mapping resource kinds to the paths of Status fields encrypted before "+
+			"being written to the Kubernetes API server, e.g. 'dbinstance=status.endpoint.address'. "+
+			"Requires --status-encryption-key-file.",
+	)
+	flag.StringVar(
+		&cfg.StatusEncryptionKeyFile, flagStatusEncryptionKeyFile,
+		"",
+		"The path of a file containing the base64-encoded AES-256 key used to encrypt the "+
+			"--encrypted-status-fields.",
+	)
+	flag.BoolVar(
+		&cfg.IgnoreRegionConflicts, flagIgnoreRegionConflicts,
+		false,
+		"Keep reconciling resources whose services.k8s.aws/region annotation conflicts with the region "+
+			"they were created in, using the latter. By default, such resources are placed in a terminal "+
+			"condition.",
+	)
+	flag.StringVar(
+		&cfg.DebugServerAddr, flagDebugServerAddr,
+		"",
+		"The address the debug endpoint, listing the reconciliations in flight and the recently completed "+
+			"ones, binds to, e.g. '127.0.0.1:8082'. The debug endpoint is disabled when empty.",
+	)
+	flag.StringArrayVar(
+		&cfg.ReferenceWatchKinds, flagReferenceWatchKinds,
+		[]string{"*"},
+		"The kinds of referenced resources whose changes immediately trigger the reconciliation of the "+
+			"resources referring to them, or '*' for all kinds. Every watched kind makes the controller react "+
+			"faster to changes of the referenced resources, at the cost of the memory and processing needed to "+
+			"track referrers and handle the events of that kind. References to other kinds are only resolved "+
+			"again when the referring resources are resynced, see --unwatched-reference-resync-seconds. "+
+			"Set to '' to disable reference watches.",
+	)
+	flag.IntVar(
+		&cfg.UnwatchedReferenceResyncSeconds, flagUnwatchedReferenceResync,
+		0,
+		"The maximum number of seconds after which synced resources referring to kinds excluded from "+
+			"--reference-watch-kinds are resynced, so that changes to the resources they reference are picked "+
+			"up. 0 means such resources are resynced after their usual resync period.",
+	)
+	flag.StringVar(
+		&cfg.OnExternalDeletion, flagOnExternalDeletion,
+		OnExternalDeletionRecreate,
+		"How managed resources whose AWS resource was deleted outside of the controller are handled. "+
+			"With 'recreate', the AWS resource is created again. With 'terminal', the resource is marked "+
+			"terminal and the AWS resource is not created again.",
+	)
+	flag.StringArrayVar(
+		&cfg.SkipInitialReadKinds, flagSkipInitialReadKinds,
+		[]string{},
+		"The kinds of resources, or '*' for all kinds, whose new resources are created without first "+
+			"reading the AWS resource, saving an API call per creation. Only applies to kinds whose AWS "+
+			"resource identifier is assigned by the client, e.g. a name specified by the user.",
+	)
+	flag.StringArrayVar(
+		&cfg.ResourceNamePatterns, flagResourceNamePatterns,
+		[]string{},
+		"A Key/Value list of strings mapping resource kinds to the regular expression the names of their "+
+			"AWS resources must match, e.g. 'bucket=^acme-[a-z0-9-]+$'. Resources whose name does not match "+
+			"are not created or updated and are marked terminal.",
+	)
+	flag.IntVar(
+		&cfg.SoftDeleteRetentionSeconds, flagSoftDeleteRetentionSeconds,
+		86400,
+		"The number of seconds during which the AWS resources of resources deleted with the 'soft-delete' "+
+			"deletion policy are kept, marked as pending deletion, before being deleted. Setting the "+
+			"services.k8s.aws/cancel-soft-delete annotation to \"true\" during that period retains the AWS resource.",
+	)
+	flag.BoolVar(
+		&cfg.EnableMetricsInstanceLabel, flagEnableMetricsInstanceLabel,
+		false,
+		"Adds the identity of the controller instance, see --instance-identity, as the controller_instance "+
+			"label of the reconcile metrics. Each controller instance then exports its own series, which "+
+			"increases the cardinality of the metrics.",
+	)
+	flag.StringVar(
+		&cfg.InstanceIdentity, flagInstanceIdentity,
+		envutil.WithDefault(envVarPodName, ""),
+		"The identity of the controller instance, e.g. its pod name. Defaults to the value of the "+
+			"POD_NAME environment variable, which can be set from the Kubernetes downward API.",
+	)
+	flag.StringArrayVar(
+		&cfg.CreateBudgets, flagCreateBudgets,
+		[]string{},
+		"A Key/Value list of strings mapping resource kinds to the maximum number of resources of that kind "+
+			"the controller creates and manages, e.g. 'cluster=5'. Once the budget of a kind is used up, the "+
+			"creation of new resources of that kind is deferred until other resources are deleted.",
+	)
+	flag.StringArrayVar(
+		&cfg.AsyncCreatePollKinds, flagAsyncCreatePollKinds,
+		[]string{},
+		"The kinds of resources, or '*' for all kinds, whose AWS resources are created asynchronously and may "+
+			"not be readable for minutes after being created. Instead of failing, the controller marks such "+
+			"resources with the services.k8s.aws/create-in-progress annotation and keeps checking whether "+
+			"their AWS resource exists every --async-create-poll-seconds.",
+	)
+	flag.IntVar(
+		&cfg.AsyncCreatePollSeconds, flagAsyncCreatePollSeconds,
+		30,
+		"The number of seconds after which the controller checks again whether an AWS resource created "+
+			"asynchronously exists, see --async-create-poll-kinds.",
+	)
+	flag.StringArrayVar(
+		&cfg.RequiredFieldValues, flagRequiredFieldValues,
+		[]string{},
+		"A list of strings mapping resource kinds to the value a field of their AWS resources must have, "+
+			"e.g. 'bucket=spec.encryption.enabled=true', to ensure managed resources are encrypted even when "+
+			"created out of band. See --required-fields-policy.",
+	)
+	flag.StringVar(
+		&cfg.RequiredFieldsPolicy, flagRequiredFieldsPolicy,
+		RequiredFieldsPolicyReport,
+		"How AWS resources lacking one of the --required-field-values are handled. With 'report', the resource "+
+			"is marked terminal. With 'enforce', the AWS resource is updated with the required Spec field values.",
+	)
+	flag.BoolVar(
+		&cfg.EnableMetadataHousekeeping, flagEnableMetadataHousekeeping,
+		false,
+		"Prune stale ACK bookkeeping annotations from synced resources when they are resynced, e.g. "+
+			"the annotations of features that were since disabled, and preserve the transition times "+
+			"of conditions whose status did not change.",
+	)
+	flag.BoolVar(
+		&cfg.WatchReferencedSecrets, flagWatchReferencedSecrets,
+		false,
+		"Watch the Secrets referenced by resources and reconcile the resources again when the data of "+
+			"their Secrets changes, e.g. when credentials are rotated. Requires permissions to list and "+
+			"watch Secrets.",
+	)
+	flag.IntVar(
+		&cfg.AccessDeniedThreshold, flagAccessDeniedThreshold,
+		0,
+		"The number of distinct resources of a kind whose reconciliation must be denied by IAM within "+
+			"--access-denied-window-seconds for the controller to report a regression of its own "+
+			"permissions, rather than isolated failures. 0 disables the detection.",
+	)
+	flag.IntVar(
+		&cfg.AccessDeniedWindowSeconds, flagAccessDeniedWindowSeconds,
+		300,
+		"The number of seconds within which access denied errors are counted towards --access-denied-threshold.",
+	)
+	flag.IntVar(
+		&cfg.AccessDeniedPauseSeconds, flagAccessDeniedPauseSeconds,
+		0,
+		"The number of seconds during which the reconciliation of the resources of a kind is paused once a "+
+			"regression of the controller's permissions is detected, to avoid flooding AWS with requests "+
+			"bound to fail. 0 keeps reconciling the resources.",
+	)
+	flag.StringSliceVar(
+		&cfg.LabelTagKeys, flagLabelTagKeys,
+		[]string{},
+		"A list of label keys, e.g. 'team,app.kubernetes.io/name', whose values on resources are propagated "+
+			"as AWS tags with the same keys. Tags are updated when the labels change and removed when the "+
+			"labels are removed. Characters not allowed in AWS tags are replaced by '_'.",
+	)
+	flag.StringVar(
+		&cfg.OnMissingSecret, flagOnMissingSecret,
+		OnMissingSecretWait,
+		"How resources referring to a Secret or Secret key that does not exist are handled. With 'wait', "+
+			"the resource is placed in an ACK.ReferencesResolved=False condition and retried after "+
+			"--missing-secret-requeue-seconds, e.g. while a GitOps tool creates the Secret. With 'fail', "+
+			"the reconciliation fails like for any other error.",
+	)
+	flag.IntVar(
+		&cfg.MissingSecretRequeueSeconds, flagMissingSecretRequeueSeconds,
+		10,
+		"The number of seconds after which resources waiting for a Secret to exist are retried. "+
+			"0 retries them after the default requeue interval.",
+	)
+	flag.Float64Var(
+		&cfg.ResyncJitterFactor, flagResyncJitterFactor,
+		0,
+		"The factor by which the resync period of each resource is randomly lengthened or shortened, e.g. "+
+			"0.1 for a random offset of up to ±10%, so that resources synced together, e.g. after the controller "+
+			"restarts, do not all resync at the same time. 0 disables the jitter.",
+	)
+	flag.StringVar(
+		&cfg.FinalizerOverride, flagFinalizerOverride,
+		"",
+		"The finalizer placed on the resources managed by the controller instead of the one of their resource "+
+			"descriptor, e.g. to avoid collisions between controllers. Resources carrying the descriptor's "+
+			"finalizer remain managed and both finalizers are removed when a resource is deleted. "+
+			"By default, the finalizer of the resource descriptor is used.",
+	)
+	flag.IntVar(
+		&cfg.MaxConcurrentReconciles, flagMaxConcurrentReconciles,
+		1,
+		"The number of resources of each kind that may be reconciled concurrently. Higher values reconcile "+
+			"large numbers of resources faster but make more concurrent AWS API calls, which may be throttled: "+
+			"tune it together with --service-max-concurrent-reconciles and the AWS API rate quotas.",
+	)
+	flag.StringArrayVar(
+		&cfg.ResourceMaxConcurrentReconciles, flagResourceMaxConcurrentReconciles,
+		[]string{},
+		"A Key/Value list of strings mapping resource kinds to the number of resources of that kind that may "+
+			"be reconciled concurrently, e.g. 'Bucket=5'. Resource-specific values take precedence over "+
+			"--max-concurrent-reconciles.",
+	)
+	flag.StringArrayVar(
+		&cfg.ResourceRateLimits, flagResourceRateLimits,
+		[]string{},
+		"A Key/Value list of strings mapping resource kinds to the maximum number of ReadOne, Create, Update "+
+			"and Delete calls per second made while reconciling resources of that kind, e.g. 'Bucket=10'. "+
+			"Reconciliations that would wait too long for their turn are requeued. 0 means no limit.",
+	)
 }
 
 // SetupLogger initializes the logger used in the service controller
@@ -254,16 +927,223 @@ func (cfg *Config) Validate() error {
 		cfg.DeletionPolicy = ackv1alpha1.DeletionPolicyDelete
 	}
 
+	return cfg.ValidateReconcileConfig()
+}
+
+// ValidateReconcileConfig validates all the configuration the reconcilers
+// depend on and returns an error listing every invalid value, so operators
+// can fix all of them at once instead of finding out when the first resource
+// is reconciled. Unlike Validate, it does not default any value nor call any
+// AWS API.
+func (cfg *Config) ValidateReconcileConfig() error {
+	var errs []error
+
+	if cfg.Region != "" && !regionPattern.MatchString(cfg.Region) {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': '%s' is not a valid AWS region", flagAWSRegion, cfg.Region))
+	}
+
+	switch cfg.DeletionPolicy {
+	case "", ackv1alpha1.DeletionPolicyDelete, ackv1alpha1.DeletionPolicyRetain, ackv1alpha1.DeletionPolicySoftDelete,
+		ackv1alpha1.DeletionPolicyOrphan:
+	default:
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': expected one of '%s', '%s', '%s' or '%s', got '%s'",
+			flagDeletionPolicy, ackv1alpha1.DeletionPolicyDelete, ackv1alpha1.DeletionPolicyRetain,
+			ackv1alpha1.DeletionPolicySoftDelete, ackv1alpha1.DeletionPolicyOrphan, cfg.DeletionPolicy))
+	}
+
 	if cfg.ReconcileDefaultResyncSeconds < 0 {
-		return fmt.Errorf("invalid value for flag '%s': resync seconds default must be greater than 0", flagReconcileDefaultResyncSeconds)
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': resync seconds default must be greater than 0", flagReconcileDefaultResyncSeconds))
 	}
 
-	_, err := cfg.ParseReconcileResourceResyncSeconds()
-	if err != nil {
-		return fmt.Errorf("invalid value for flag '%s': %v", flagReconcileResourceResyncSeconds, err)
+	if _, err := cfg.ParseReconcileResourceResyncSeconds(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagReconcileResourceResyncSeconds, err))
 	}
 
-	return nil
+	if _, err := cfg.ParseRequeueOnSuccessOverrides(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagRequeueOnSuccessOverrides, err))
+	}
+
+	if _, err := cfg.ParseCreateBudgets(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagCreateBudgets, err))
+	}
+
+	if cfg.CanaryAnnotationKey == "" && cfg.CanaryAnnotationValue != "" {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': '%s' must also be set", flagCanaryAnnotationValue, flagCanaryAnnotationKey))
+	}
+
+	if cfg.OrphanedResourcesConfigMap != "" {
+		if _, _, err := cfg.ParseOrphanedResourcesConfigMap(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagOrphanedResourcesConfigMap, err))
+		}
+	}
+
+	if cfg.DefaultServiceMaxConcurrency < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': concurrency must not be negative", flagDefaultServiceMaxConcurrency))
+	}
+
+	if _, err := cfg.ParseServiceMaxConcurrentReconciles(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagServiceMaxConcurrentReconciles, err))
+	}
+
+	if cfg.BackpressurePatchLatencyMs < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': threshold must not be negative", flagBackpressurePatchLatency))
+	}
+
+	if cfg.PolicyEndpointURL != "" {
+		if _, err := url.ParseRequestURI(cfg.PolicyEndpointURL); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagPolicyEndpointURL, err))
+		}
+		if cfg.PolicyTimeoutSeconds <= 0 {
+			errs = append(errs, fmt.Errorf("invalid value for flag '%s': timeout must be greater than 0", flagPolicyTimeoutSeconds))
+		}
+	}
+
+	if _, err := cfg.ParseProtectedClearedFields(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagProtectedClearedFields, err))
+	}
+
+	switch cfg.ClearedFieldsPolicy {
+	case "", ClearedFieldsPolicyConfirm, ClearedFieldsPolicyIgnore:
+	default:
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': must be one of '%s' or '%s', got '%s'",
+			flagClearedFieldsPolicy, ClearedFieldsPolicyConfirm, ClearedFieldsPolicyIgnore, cfg.ClearedFieldsPolicy))
+	}
+
+	if _, err := cfg.ParseEncryptedStatusFields(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagEncryptedStatusFields, err))
+	}
+
+	if len(cfg.EncryptedStatusFields) > 0 || cfg.StatusEncryptionKeyFile != "" {
+		if _, err := cfg.LoadStatusEncryptionKey(); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagStatusEncryptionKeyFile, err))
+		}
+	}
+
+	if cfg.RequeueAdoptedResourceNotFound && cfg.AdoptedNotFoundRequeueSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': requeue seconds must be greater than 0", flagAdoptedNotFoundRequeueSeconds))
+	}
+
+	if _, err := cfg.ParseResourceNamePatterns(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagResourceNamePatterns, err))
+	}
+
+	if _, err := cfg.ParseRequiredFieldValues(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagRequiredFieldValues, err))
+	}
+
+	switch cfg.RequiredFieldsPolicy {
+	case "", RequiredFieldsPolicyReport, RequiredFieldsPolicyEnforce:
+	default:
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': must be one of '%s' or '%s', got '%s'",
+			flagRequiredFieldsPolicy, RequiredFieldsPolicyReport, RequiredFieldsPolicyEnforce, cfg.RequiredFieldsPolicy))
+	}
+
+	if cfg.ResyncJitterFactor < 0 || cfg.ResyncJitterFactor >= 1 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': jitter factor must be at least 0 and less than 1", flagResyncJitterFactor))
+	}
+
+	if cfg.MaxConcurrentReconciles < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': must be greater than or equal to 0", flagMaxConcurrentReconciles))
+	}
+
+	if _, err := cfg.ParseResourceMaxConcurrentReconciles(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagResourceMaxConcurrentReconciles, err))
+	}
+
+	if _, err := cfg.ParseResourceRateLimits(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagResourceRateLimits, err))
+	}
+
+	if cfg.FinalizerOverride != "" {
+		if msgs := validation.IsQualifiedName(cfg.FinalizerOverride); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("invalid value for flag '%s': %s", flagFinalizerOverride, strings.Join(msgs, ", ")))
+		}
+	}
+
+	switch cfg.OnMissingSecret {
+	case "", OnMissingSecretWait, OnMissingSecretFail:
+	default:
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': must be one of '%s' or '%s', got '%s'",
+			flagOnMissingSecret, OnMissingSecretWait, OnMissingSecretFail, cfg.OnMissingSecret))
+	}
+	if cfg.MissingSecretRequeueSeconds < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': requeue seconds must not be negative", flagMissingSecretRequeueSeconds))
+	}
+
+	switch cfg.OnExternalDeletion {
+	case "", OnExternalDeletionRecreate, OnExternalDeletionTerminal:
+	default:
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': must be one of '%s' or '%s', got '%s'",
+			flagOnExternalDeletion, OnExternalDeletionRecreate, OnExternalDeletionTerminal, cfg.OnExternalDeletion))
+	}
+
+	if cfg.EnableMetricsInstanceLabel && cfg.InstanceIdentity == "" {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': an instance identity is required when '%s' is set",
+			flagInstanceIdentity, flagEnableMetricsInstanceLabel))
+	}
+
+	for _, key := range cfg.LabelTagKeys {
+		if strings.TrimSpace(key) == "" {
+			errs = append(errs, fmt.Errorf("invalid value for flag '%s': label keys must not be empty", flagLabelTagKeys))
+			break
+		}
+	}
+
+	if cfg.AccessDeniedThreshold < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': threshold must not be negative", flagAccessDeniedThreshold))
+	}
+	if cfg.AccessDeniedThreshold > 0 && cfg.AccessDeniedWindowSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': window seconds must be greater than 0", flagAccessDeniedWindowSeconds))
+	}
+	if cfg.AccessDeniedPauseSeconds < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': pause seconds must not be negative", flagAccessDeniedPauseSeconds))
+	}
+
+	if len(cfg.AsyncCreatePollKinds) > 0 && cfg.AsyncCreatePollSeconds <= 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': poll seconds must be greater than 0", flagAsyncCreatePollSeconds))
+	}
+
+	if cfg.SoftDeleteRetentionSeconds < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': retention seconds must not be negative", flagSoftDeleteRetentionSeconds))
+	}
+
+	if cfg.UnwatchedReferenceResyncSeconds < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': resync seconds must not be negative", flagUnwatchedReferenceResync))
+	}
+
+	if cfg.QuotaExceededRequeueSeconds < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': requeue seconds must not be negative", flagQuotaExceededRequeueSeconds))
+	}
+
+	if cfg.SessionCacheTTLSeconds < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': TTL must not be negative", flagSessionCacheTTLSeconds))
+	}
+
+	if cfg.UnresolvedAccountRequeueSeconds < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': requeue seconds must not be negative", flagUnresolvedAccountRequeueSeconds))
+	}
+
+	if cfg.ConditionTransitionLogSize < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': size must not be negative", flagConditionTransitionLogSize))
+	}
+
+	if cfg.MaxReferenceReads < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': limit must not be negative", flagMaxReferenceReads))
+	}
+
+	if cfg.RecentEventsLimit < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': limit must not be negative", flagRecentEventsLimit))
+	}
+
+	if _, err := cfg.ParseResourceLoggerFields(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagResourceLoggerFields, err))
+	}
+
+	if _, err := cfg.ParseAWSErrorSeverities(); err != nil {
+		errs = append(errs, fmt.Errorf("invalid value for flag '%s': %v", flagAWSErrorSeverities, err))
+	}
+
+	return utilerrors.NewAggregate(errs)
 }
 
 func (cfg *Config) checkUnsafeEndpoint(endpoint *url.URL) error {
@@ -293,6 +1173,326 @@ func (cfg *Config) ParseReconcileResourceResyncSeconds() (map[string]time.Durati
 	return resourceResyncPeriods, nil
 }
 
+// ParseResourceMaxConcurrentReconciles parses the values of the
+// --resource-max-concurrent-reconciles flag and returns a map that maps
+// lower-cased resource kinds to the number of resources of that kind that may
+// be reconciled concurrently. The flag arguments are expected to have the
+// format "resource=workers".
+func (cfg *Config) ParseResourceMaxConcurrentReconciles() (map[string]int, error) {
+	resourceConcurrency := make(map[string]int, len(cfg.ResourceMaxConcurrentReconciles))
+	for _, resourceConcurrencyFlag := range cfg.ResourceMaxConcurrentReconciles {
+		resourceName, workers, err := parseReconcileFlagArgument(resourceConcurrencyFlag)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing flag argument '%v': %v. Expected format: resource=workers", resourceConcurrencyFlag, err)
+		}
+		resourceConcurrency[strings.ToLower(resourceName)] = workers
+	}
+	return resourceConcurrency, nil
+}
+
+// GetMaxConcurrentReconciles returns the number of resources of the supplied
+// kind that may be reconciled concurrently by its reconciler, that is the
+// value set for the kind with --resource-max-concurrent-reconciles, or
+// --max-concurrent-reconciles otherwise. Values of 0 mean 1.
+func (cfg *Config) GetMaxConcurrentReconciles(resourceKind string) int {
+	resourceConcurrency, err := cfg.ParseResourceMaxConcurrentReconciles()
+	if err == nil {
+		if workers, ok := resourceConcurrency[strings.ToLower(resourceKind)]; ok && workers > 0 {
+			return workers
+		}
+	}
+	if cfg.MaxConcurrentReconciles < 1 {
+		return 1
+	}
+	return cfg.MaxConcurrentReconciles
+}
+
+// ParseResourceRateLimits parses the values of the --resource-rate-limits flag
+// and returns a map that maps lower-cased resource kinds to the maximum number
+// of resource manager calls per second made while reconciling resources of
+// that kind. The flag arguments are expected to have the format
+// "resource=rps".
+func (cfg *Config) ParseResourceRateLimits() (map[string]int, error) {
+	rateLimits := make(map[string]int, len(cfg.ResourceRateLimits))
+	for _, rateLimitFlag := range cfg.ResourceRateLimits {
+		resourceName, rps, err := parseReconcileFlagArgument(rateLimitFlag)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing flag argument '%v': %v. Expected format: resource=rps", rateLimitFlag, err)
+		}
+		rateLimits[strings.ToLower(resourceName)] = rps
+	}
+	return rateLimits, nil
+}
+
+// ParseRequeueOnSuccessOverrides parses the values of the
+// --requeue-on-success-seconds flag and returns a map that maps lower-cased
+// resource kinds to the period after which successfully synced resources of
+// that kind are requeued. The flag arguments are expected to have the format
+// "resource=seconds".
+func (cfg *Config) ParseRequeueOnSuccessOverrides() (map[string]time.Duration, error) {
+	overrides := make(map[string]time.Duration, len(cfg.RequeueOnSuccessOverrides))
+	for _, overrideFlag := range cfg.RequeueOnSuccessOverrides {
+		resourceName, seconds, err := parseReconcileFlagArgument(overrideFlag)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing flag argument '%v': %v. Expected format: resource=seconds", overrideFlag, err)
+		}
+		overrides[strings.ToLower(resourceName)] = time.Duration(seconds) * time.Second
+	}
+	return overrides, nil
+}
+
+// ParseCreateBudgets parses the values of the --create-budgets flag and
+// returns a map that maps lower-cased resource kinds to the maximum number of
+// resources of that kind the controller creates and manages. The flag
+// arguments are expected to have the format "resource=count".
+func (cfg *Config) ParseCreateBudgets() (map[string]int, error) {
+	budgets := make(map[string]int, len(cfg.CreateBudgets))
+	for _, budgetFlag := range cfg.CreateBudgets {
+		resourceName, count, err := parseReconcileFlagArgument(budgetFlag)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing flag argument '%v': %v. Expected format: resource=count", budgetFlag, err)
+		}
+		budgets[strings.ToLower(resourceName)] = count
+	}
+	return budgets, nil
+}
+
+// ParseProtectedClearedFields parses the values of the
+// --protected-cleared-fields flag and returns a map that maps lower-cased
+// resource kinds to the paths of the fields, in dotted notation, that must not
+// be cleared without confirmation. The flag arguments are expected to have the
+// format "resource=path", e.g. "bucket=Spec.Policy".
+func (cfg *Config) ParseProtectedClearedFields() (map[string][]string, error) {
+	fields := make(map[string][]string, len(cfg.ProtectedClearedFields))
+	for _, fieldFlag := range cfg.ProtectedClearedFields {
+		elements := strings.Split(fieldFlag, "=")
+		if len(elements) != 2 || elements[0] == "" || elements[1] == "" {
+			return nil, fmt.Errorf("error parsing flag argument '%v'. Expected format: resource=path", fieldFlag)
+		}
+		kind := strings.ToLower(elements[0])
+		fields[kind] = append(fields[kind], elements[1])
+	}
+	return fields, nil
+}
+
+// ParseResourceNamePatterns parses the values of the --resource-name-patterns
+// flag and returns a map that maps lower-cased resource kinds to the regular
+// expression the names of their AWS resources must match. The flag arguments
+// are expected to have the format "resource=regex", e.g.
+// "bucket=^acme-[a-z0-9-]+$".
+func (cfg *Config) ParseResourceNamePatterns() (map[string]*regexp.Regexp, error) {
+	patterns := make(map[string]*regexp.Regexp, len(cfg.ResourceNamePatterns))
+	for _, patternFlag := range cfg.ResourceNamePatterns {
+		kind, expr, found := strings.Cut(patternFlag, "=")
+		if !found || kind == "" || expr == "" {
+			return nil, fmt.Errorf("error parsing flag argument '%v'. Expected format: resource=regex", patternFlag)
+		}
+		pattern, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing flag argument '%v': %v", patternFlag, err)
+		}
+		patterns[strings.ToLower(kind)] = pattern
+	}
+	return patterns, nil
+}
+
+// ReferenceWatchEnabled returns true if changes to referenced resources of
+// the supplied kind should trigger the reconciliation of the resources
+// referring to them, according to the --reference-watch-kinds flag. The kind
+// is matched case-insensitively and "*" matches every kind. All kinds are
+// watched when the flag was never bound.
+func (cfg *Config) ReferenceWatchEnabled(kind string) bool {
+	if cfg.ReferenceWatchKinds == nil {
+		return true
+	}
+	for _, watched := range cfg.ReferenceWatchKinds {
+		if watched == "*" || strings.EqualFold(watched, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// SkipInitialReadEnabled returns true if the read preceding the creation of
+// new resources of the supplied kind may be skipped, according to the
+// --skip-initial-read-kinds flag. The kind is matched case-insensitively and
+// "*" matches every kind.
+func (cfg *Config) SkipInitialReadEnabled(kind string) bool {
+	for _, skipped := range cfg.SkipInitialReadKinds {
+		if skipped == "*" || strings.EqualFold(skipped, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// AsyncCreatePollEnabled returns true if the controller keeps checking
+// whether the AWS resources of the supplied kind it created exist, rather
+// than failing, when they cannot be read right after being created, according
+// to the --async-create-poll-kinds flag. The kind is matched
+// case-insensitively and "*" matches every kind.
+func (cfg *Config) AsyncCreatePollEnabled(kind string) bool {
+	for _, polled := range cfg.AsyncCreatePollKinds {
+		if polled == "*" || strings.EqualFold(polled, kind) {
+			return true
+		}
+	}
+	return false
+}
+
+// ParseEncryptedStatusFields parses the values of the
+// --encrypted-status-fields flag into a map of lowercase resource kinds to
+// the paths of the Status fields encrypted before being written. The flag
+// arguments are expected to have the format "resource=status.path".
+func (cfg *Config) ParseEncryptedStatusFields() (map[string][]string, error) {
+	fields := make(map[string][]string, len(cfg.EncryptedStatusFields))
+	for _, fieldFlag := range cfg.EncryptedStatusFields {
+		kind, path, found := strings.Cut(fieldFlag, "=")
+		if !found || kind == "" || !strings.HasPrefix(path, "status.") || path == "status." {
+			return nil, fmt.Errorf("error parsing flag argument '%v'. Expected format: resource=status.path", fieldFlag)
+		}
+		kind = strings.ToLower(kind)
+		fields[kind] = append(fields[kind], path)
+	}
+	return fields, nil
+}
+
+// ParseRequiredFieldValues parses the values of the --required-field-values
+// flag and returns a map that maps lower-cased resource kinds to the values,
+// keyed by path in dotted JSON notation, that the fields of their AWS
+// resources must have. The flag arguments are expected to have the format
+// "resource=path=value", e.g. "bucket=spec.encryption.enabled=true".
+func (cfg *Config) ParseRequiredFieldValues() (map[string]map[string]string, error) {
+	values := make(map[string]map[string]string, len(cfg.RequiredFieldValues))
+	for _, valueFlag := range cfg.RequiredFieldValues {
+		kind, assignment, _ := strings.Cut(valueFlag, "=")
+		path, value, found := strings.Cut(assignment, "=")
+		if !found || kind == "" || value == "" ||
+			!(strings.HasPrefix(path, "spec.") || strings.HasPrefix(path, "status.")) {
+			return nil, fmt.Errorf("error parsing flag argument '%v'. Expected format: resource=path=value", valueFlag)
+		}
+		kind = strings.ToLower(kind)
+		if values[kind] == nil {
+			values[kind] = map[string]string{}
+		}
+		values[kind][path] = value
+	}
+	return values, nil
+}
+
+// LoadStatusEncryptionKey reads the base64-encoded AES-256 key from the file
+// set with the --status-encryption-key-file flag.
+func (cfg *Config) LoadStatusEncryptionKey() ([]byte, error) {
+	if cfg.StatusEncryptionKeyFile == "" {
+		return nil, fmt.Errorf("a key file is required to encrypt status fields")
+	}
+	encoded, err := os.ReadFile(cfg.StatusEncryptionKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return encryption.ParseKey(string(encoded))
+}
+
+// ParseResourceLoggerFields parses the values of the --resource-logger-fields
+// flag. The flag arguments are expected to have the format
+// "label:key=field" or "annotation:key=field", where "key" is the key of the
+// label or annotation and "field" the name of the log field. When "=field" is
+// omitted, the key is used as the name of the log field.
+func (cfg *Config) ParseResourceLoggerFields() ([]ResourceLoggerField, error) {
+	fields := make([]ResourceLoggerField, 0, len(cfg.ResourceLoggerFields))
+	for _, fieldFlag := range cfg.ResourceLoggerFields {
+		source, keyAndName, found := strings.Cut(fieldFlag, ":")
+		if !found || (source != ResourceLoggerFieldSourceLabel && source != ResourceLoggerFieldSourceAnnotation) {
+			return nil, fmt.Errorf("error parsing flag argument '%v'. Expected format: label:key=field or annotation:key=field", fieldFlag)
+		}
+		key, name, found := strings.Cut(keyAndName, "=")
+		if !found {
+			name = key
+		}
+		if key == "" || name == "" {
+			return nil, fmt.Errorf("error parsing flag argument '%v'. Expected format: %s:key=field", fieldFlag, source)
+		}
+		fields = append(fields, ResourceLoggerField{Source: source, Key: key, Name: name})
+	}
+	return fields, nil
+}
+
+// ParseServiceMaxConcurrentReconciles parses the values of the
+// --service-max-concurrent-reconciles flag and returns a map that maps service
+// aliases to the maximum number of reconciliations of that service's resources
+// that may run concurrently. The flag arguments are expected to have the
+// format "service=concurrency", e.g. "ec2=5".
+func (cfg *Config) ParseServiceMaxConcurrentReconciles() (map[string]int, error) {
+	serviceConcurrency := make(map[string]int, len(cfg.ServiceMaxConcurrentReconciles))
+	for _, serviceConcurrencyFlag := range cfg.ServiceMaxConcurrentReconciles {
+		serviceAlias, concurrency, err := parseReconcileFlagArgument(serviceConcurrencyFlag)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing flag argument '%v': %v. Expected format: service=concurrency", serviceConcurrencyFlag, err)
+		}
+		serviceConcurrency[strings.ToLower(serviceAlias)] = concurrency
+	}
+	return serviceConcurrency, nil
+}
+
+// GetServiceMaxConcurrentReconciles returns the maximum number of
+// reconciliations of the supplied service's resources that may run
+// concurrently across all the reconcilers of the controller. Zero means that
+// the number of concurrent reconciliations is not limited.
+func (cfg *Config) GetServiceMaxConcurrentReconciles(serviceAlias string) int {
+	serviceConcurrency, err := cfg.ParseServiceMaxConcurrentReconciles()
+	if err == nil {
+		if concurrency, ok := serviceConcurrency[strings.ToLower(serviceAlias)]; ok {
+			return concurrency
+		}
+	}
+	return cfg.DefaultServiceMaxConcurrency
+}
+
+// ParseAWSErrorSeverities parses the values of the --aws-error-severity flag
+// and returns a map that maps AWS error codes to the severity with which the
+// reconciler handles them. The flag arguments are expected to have the format
+// "code=terminal", "code=recoverable" or "code=requeue-after:seconds".
+func (cfg *Config) ParseAWSErrorSeverities() (map[string]AWSErrorSeverity, error) {
+	severities := make(map[string]AWSErrorSeverity, len(cfg.AWSErrorSeverities))
+	for _, severityFlag := range cfg.AWSErrorSeverities {
+		elements := strings.Split(severityFlag, "=")
+		if len(elements) != 2 || elements[0] == "" || elements[1] == "" {
+			return nil, fmt.Errorf("error parsing flag argument '%v'. Expected format: code=severity", severityFlag)
+		}
+		severity, err := parseAWSErrorSeverity(elements[1])
+		if err != nil {
+			return nil, fmt.Errorf("error parsing flag argument '%v': %v", severityFlag, err)
+		}
+		severities[elements[0]] = severity
+	}
+	return severities, nil
+}
+
+// parseAWSErrorSeverity parses a severity of the form "terminal",
+// "recoverable" or "requeue-after:seconds".
+func parseAWSErrorSeverity(value string) (AWSErrorSeverity, error) {
+	switch value {
+	case AWSErrorSeverityTerminal, AWSErrorSeverityRecoverable:
+		return AWSErrorSeverity{Kind: value}, nil
+	}
+	prefix := AWSErrorSeverityRequeueAfter + ":"
+	if !strings.HasPrefix(value, prefix) {
+		return AWSErrorSeverity{}, fmt.Errorf(
+			"unknown severity '%s', expected one of %s, %s or %s:seconds", value,
+			AWSErrorSeverityTerminal, AWSErrorSeverityRecoverable, AWSErrorSeverityRequeueAfter,
+		)
+	}
+	seconds := strings.TrimPrefix(value, prefix)
+	requeueSeconds, err := strconv.Atoi(seconds)
+	if err != nil || requeueSeconds <= 0 {
+		return AWSErrorSeverity{}, fmt.Errorf("invalid requeue seconds '%s', expected a positive integer", seconds)
+	}
+	return AWSErrorSeverity{
+		Kind:         AWSErrorSeverityRequeueAfter,
+		RequeueAfter: time.Duration(requeueSeconds) * time.Second,
+	}, nil
+}
+
 // parseReconcileFlagArgument parses a flag argument of the form "key=value" into
 // its individual elements. The key must be a non-empty string and the value must be
 // a non-empty positive integer. If the flag argument is not in the expected format
@@ -321,3 +1521,14 @@ func parseReconcileFlagArgument(flagArgument string) (string, int, error) {
 	}
 	return elements[0], resyncSeconds, nil
 }
+
+// ParseOrphanedResourcesConfigMap parses the value of the
+// --orphaned-resources-configmap flag, expected to have the format
+// "namespace/name", and returns the namespace and name of the ConfigMap.
+func (cfg *Config) ParseOrphanedResourcesConfigMap() (string, string, error) {
+	elements := strings.Split(cfg.OrphanedResourcesConfigMap, "/")
+	if len(elements) != 2 || elements[0] == "" || elements[1] == "" {
+		return "", "", fmt.Errorf("invalid format: expected namespace/name, got '%s'", cfg.OrphanedResourcesConfigMap)
+	}
+	return elements[0], elements[1], nil
+}
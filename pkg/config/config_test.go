@@ -13,7 +13,11 @@
 
 package config
 
-import "testing"
+import (
+	"strings"
+	"testing"
+	"time"
+)
 
 func TestParseReconcileFlagArgument(t *testing.T) {
 	tests := []struct {
@@ -59,3 +63,324 @@ func TestParseReconcileFlagArgument(t *testing.T) {
 		}
 	}
 }
+
+func TestParseOrphanedResourcesConfigMap(t *testing.T) {
+	tests := []struct {
+		flagValue         string
+		expectedNamespace string
+		expectedName      string
+		expectedErr       bool
+	}{
+		{"ack-system/orphans", "ack-system", "orphans", false},
+		{"orphans", "", "", true},
+		{"/orphans", "", "", true},
+		{"ack-system/", "", "", true},
+		{"ack-system/orphans/extra", "", "", true},
+	}
+	for _, test := range tests {
+		cfg := Config{OrphanedResourcesConfigMap: test.flagValue}
+		namespace, name, err := cfg.ParseOrphanedResourcesConfigMap()
+		if err != nil && !test.expectedErr {
+			t.Errorf("unexpected error for flag value '%s': %v", test.flagValue, err)
+		}
+		if err == nil && test.expectedErr {
+			t.Errorf("expected error for flag value '%s', got nil", test.flagValue)
+		}
+		if namespace != test.expectedNamespace {
+			t.Errorf("unexpected namespace for flag value '%s': expected '%s', got '%s'", test.flagValue, test.expectedNamespace, namespace)
+		}
+		if name != test.expectedName {
+			t.Errorf("unexpected name for flag value '%s': expected '%s', got '%s'", test.flagValue, test.expectedName, name)
+		}
+	}
+}
+
+func TestGetServiceMaxConcurrentReconciles(t *testing.T) {
+	cfg := Config{
+		ServiceMaxConcurrentReconciles: []string{"ec2=5", "S3=0"},
+		DefaultServiceMaxConcurrency:   10,
+	}
+	tests := []struct {
+		serviceAlias string
+		expected     int
+	}{
+		{"ec2", 5},
+		{"s3", 0},
+		{"sns", 10},
+	}
+	for _, test := range tests {
+		got := cfg.GetServiceMaxConcurrentReconciles(test.serviceAlias)
+		if got != test.expected {
+			t.Errorf("unexpected concurrency for service '%s': expected %d, got %d", test.serviceAlias, test.expected, got)
+		}
+	}
+}
+
+func TestValidateReconcileConfig(t *testing.T) {
+	cfg := Config{
+		Region:                         "us-west-2",
+		DeletionPolicy:                 "orphan",
+		ReconcileResourceResyncSeconds: []string{"bucket=60"},
+		OnExternalDeletion:             OnExternalDeletionTerminal,
+		FinalizerOverride:              "example.com/finalizer",
+	}
+	if err := cfg.ValidateReconcileConfig(); err != nil {
+		t.Errorf("unexpected error for valid config: %v", err)
+	}
+
+	cfg = Config{
+		Region:                         "us west 2",
+		DeletionPolicy:                 "destroy",
+		ReconcileResourceResyncSeconds: []string{"bucket"},
+		OnExternalDeletion:             "ignore",
+		EnableMetricsInstanceLabel:     true,
+		CreateBudgets:                  []string{"cluster=-1"},
+		AccessDeniedThreshold:          10,
+		OnMissingSecret:                "ignore",
+		FinalizerOverride:              "not a finalizer",
+	}
+	err := cfg.ValidateReconcileConfig()
+	if err == nil {
+		t.Fatalf("expected error for invalid config, got nil")
+	}
+	for _, flagName := range []string{flagAWSRegion, flagDeletionPolicy, flagReconcileResourceResyncSeconds, flagOnExternalDeletion, flagInstanceIdentity, flagCreateBudgets, flagAccessDeniedWindowSeconds, flagOnMissingSecret, flagFinalizerOverride} {
+		if !strings.Contains(err.Error(), flagName) {
+			t.Errorf("expected error to mention flag '%s', got '%v'", flagName, err)
+		}
+	}
+}
+
+func TestParseAWSErrorSeverities(t *testing.T) {
+	cfg := Config{
+		AWSErrorSeverities: []string{
+			"LimitExceededException=terminal",
+			"InvalidParameterValue=recoverable",
+			"ThrottlingException=requeue-after:30",
+		},
+	}
+	severities, err := cfg.ParseAWSErrorSeverities()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]AWSErrorSeverity{
+		"LimitExceededException": {Kind: AWSErrorSeverityTerminal},
+		"InvalidParameterValue":  {Kind: AWSErrorSeverityRecoverable},
+		"ThrottlingException":    {Kind: AWSErrorSeverityRequeueAfter, RequeueAfter: 30 * time.Second},
+	}
+	for code, severity := range expected {
+		if severities[code] != severity {
+			t.Errorf("unexpected severity for code '%s': expected %+v, got %+v", code, severity, severities[code])
+		}
+	}
+
+	for _, invalid := range []string{
+		"LimitExceededException",
+		"=terminal",
+		"LimitExceededException=fatal",
+		"ThrottlingException=requeue-after:0",
+		"ThrottlingException=requeue-after:soon",
+	} {
+		cfg := Config{AWSErrorSeverities: []string{invalid}}
+		if _, err := cfg.ParseAWSErrorSeverities(); err == nil {
+			t.Errorf("expected error for flag argument '%s', got nil", invalid)
+		}
+	}
+}
+
+func TestParseRequeueOnSuccessOverrides(t *testing.T) {
+	cfg := Config{
+		RequeueOnSuccessOverrides: []string{"Bucket=600", "topic=30"},
+	}
+	overrides, err := cfg.ParseRequeueOnSuccessOverrides()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := map[string]time.Duration{
+		"bucket": 600 * time.Second,
+		"topic":  30 * time.Second,
+	}
+	for kind, duration := range expected {
+		if overrides[kind] != duration {
+			t.Errorf("unexpected override for kind '%s': expected %v, got %v", kind, duration, overrides[kind])
+		}
+	}
+
+	cfg = Config{RequeueOnSuccessOverrides: []string{"bucket=soon"}}
+	if _, err := cfg.ParseRequeueOnSuccessOverrides(); err == nil {
+		t.Errorf("expected error for invalid override, got nil")
+	}
+}
+
+func TestParseResourceLoggerFields(t *testing.T) {
+	cfg := Config{
+		ResourceLoggerFields: []string{
+			"label:example.com/business-unit=business_unit",
+			"annotation:owner",
+		},
+	}
+	fields, err := cfg.ParseResourceLoggerFields()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []ResourceLoggerField{
+		{Source: ResourceLoggerFieldSourceLabel, Key: "example.com/business-unit", Name: "business_unit"},
+		{Source: ResourceLoggerFieldSourceAnnotation, Key: "owner", Name: "owner"},
+	}
+	if len(fields) != len(expected) {
+		t.Fatalf("unexpected number of fields: expected %d, got %d", len(expected), len(fields))
+	}
+	for i := range expected {
+		if fields[i] != expected[i] {
+			t.Errorf("unexpected field %d: expected %+v, got %+v", i, expected[i], fields[i])
+		}
+	}
+
+	for _, invalid := range []string{"owner", "tag:owner", "label:", "label:owner="} {
+		cfg := Config{ResourceLoggerFields: []string{invalid}}
+		if _, err := cfg.ParseResourceLoggerFields(); err == nil {
+			t.Errorf("expected error for '%s', got nil", invalid)
+		}
+	}
+}
+
+func TestParseProtectedClearedFields(t *testing.T) {
+	cfg := Config{
+		ProtectedClearedFields: []string{"Bucket=Spec.Policy", "bucket=Spec.Logging", "topic=Spec.Policy"},
+	}
+	fields, err := cfg.ParseProtectedClearedFields()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fields["bucket"]) != 2 || fields["bucket"][0] != "Spec.Policy" || fields["bucket"][1] != "Spec.Logging" {
+		t.Errorf("unexpected protected fields for bucket: %v", fields["bucket"])
+	}
+	if len(fields["topic"]) != 1 || fields["topic"][0] != "Spec.Policy" {
+		t.Errorf("unexpected protected fields for topic: %v", fields["topic"])
+	}
+
+	cfg = Config{
+		ProtectedClearedFields: []string{"Spec.Policy"},
+		ClearedFieldsPolicy:    "drop",
+	}
+	err = cfg.ValidateReconcileConfig()
+	if err == nil {
+		t.Fatalf("expected error for invalid config, got nil")
+	}
+	for _, flagName := range []string{flagProtectedClearedFields, flagClearedFieldsPolicy} {
+		if !strings.Contains(err.Error(), flagName) {
+			t.Errorf("expected error to mention flag '%s', got '%v'", flagName, err)
+		}
+	}
+}
+
+func TestParseResourceNamePatterns(t *testing.T) {
+	cfg := Config{
+		ResourceNamePatterns: []string{"Bucket=^acme-[a-z0-9=-]+$"},
+	}
+	patterns, err := cfg.ParseResourceNamePatterns()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patterns["bucket"] == nil || !patterns["bucket"].MatchString("acme-logs") {
+		t.Errorf("unexpected pattern for bucket: %v", patterns["bucket"])
+	}
+	if patterns["bucket"].MatchString("logs") {
+		t.Errorf("expected pattern for bucket not to match 'logs'")
+	}
+
+	for _, invalid := range []string{"bucket", "=^acme-", "bucket=", "bucket=acme-("} {
+		cfg = Config{ResourceNamePatterns: []string{invalid}}
+		if _, err := cfg.ParseResourceNamePatterns(); err == nil {
+			t.Errorf("expected error for '%s', got nil", invalid)
+		}
+	}
+}
+
+func TestParseEncryptedStatusFields(t *testing.T) {
+	cfg := Config{
+		EncryptedStatusFields: []string{"DBInstance=status.endpoint.address", "dbinstance=status.masterUserSecret"},
+	}
+	fields, err := cfg.ParseEncryptedStatusFields()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(fields["dbinstance"]) != 2 || fields["dbinstance"][0] != "status.endpoint.address" {
+		t.Errorf("unexpected encrypted fields for dbinstance: %v", fields["dbinstance"])
+	}
+
+	for _, invalid := range []string{"status.endpoint.address", "dbinstance=spec.password", "dbinstance=status."} {
+		cfg := Config{EncryptedStatusFields: []string{invalid}}
+		if _, err := cfg.ParseEncryptedStatusFields(); err == nil {
+			t.Errorf("expected error for '%s', got nil", invalid)
+		}
+	}
+
+	cfg = Config{EncryptedStatusFields: []string{"dbinstance=status.endpoint.address"}}
+	if _, err := cfg.LoadStatusEncryptionKey(); err == nil {
+		t.Errorf("expected error for missing key file, got nil")
+	}
+}
+
+func TestParseRequiredFieldValues(t *testing.T) {
+	cfg := Config{
+		RequiredFieldValues: []string{"Bucket=spec.encryption.enabled=true", "bucket=status.kmsKeyID=alias/acme"},
+	}
+	values, err := cfg.ParseRequiredFieldValues()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(values["bucket"]) != 2 || values["bucket"]["status.kmsKeyID"] != "alias/acme" {
+		t.Errorf("unexpected required values for bucket: %v", values["bucket"])
+	}
+
+	for _, invalid := range []string{"bucket", "bucket=spec.encrypted", "bucket=encrypted=true", "=spec.encrypted=true"} {
+		cfg := Config{RequiredFieldValues: []string{invalid}}
+		if _, err := cfg.ParseRequiredFieldValues(); err == nil {
+			t.Errorf("expected error for '%s', got nil", invalid)
+		}
+	}
+}
+
+func TestReferenceWatchEnabled(t *testing.T) {
+	tests := []struct {
+		watchKinds []string
+		kind       string
+		expected   bool
+	}{
+		{nil, "Bucket", true},
+		{[]string{"*"}, "Bucket", true},
+		{[]string{"bucket", "Topic"}, "Bucket", true},
+		{[]string{"bucket", "Topic"}, "Queue", false},
+		{[]string{""}, "Bucket", false},
+	}
+	for _, test := range tests {
+		cfg := Config{ReferenceWatchKinds: test.watchKinds}
+		if got := cfg.ReferenceWatchEnabled(test.kind); got != test.expected {
+			t.Errorf("unexpected result for kinds %v and kind '%s': expected %v, got %v", test.watchKinds, test.kind, test.expected, got)
+		}
+	}
+}
+
+func TestGetMaxConcurrentReconciles(t *testing.T) {
+	cfg := Config{
+		ResourceMaxConcurrentReconciles: []string{"Bucket=5", "Queue=0"},
+		MaxConcurrentReconciles:         3,
+	}
+	tests := []struct {
+		resourceKind string
+		expected     int
+	}{
+		{"bucket", 5},
+		{"queue", 3},
+		{"topic", 3},
+	}
+	for _, test := range tests {
+		got := cfg.GetMaxConcurrentReconciles(test.resourceKind)
+		if got != test.expected {
+			t.Errorf("unexpected concurrency for resource '%s': expected %d, got %d", test.resourceKind, test.expected, got)
+		}
+	}
+	if got := (&Config{}).GetMaxConcurrentReconciles("bucket"); got != 1 {
+		t.Errorf("unexpected default concurrency: expected 1, got %d", got)
+	}
+}
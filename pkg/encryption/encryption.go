@@ -0,0 +1,103 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+// Package encryption encrypts the Status field values that the ACK runtime is
+// configured to protect at rest, and allows consumers of those resources to
+// decrypt them.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// Prefix marks the values encrypted by Encrypt
+	Prefix = "ack-encrypted:v1:"
+	// KeySize is the size, in bytes, of the AES-256 keys used to encrypt
+	// values
+	KeySize = 32
+)
+
+// ParseKey decodes a base64-encoded AES-256 key, ignoring surrounding
+// whitespace.
+func ParseKey(encoded string) ([]byte, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encoded))
+	if err != nil {
+		return nil, fmt.Errorf("key is not base64-encoded: %v", err)
+	}
+	if len(key) != KeySize {
+		return nil, fmt.Errorf("expected a %d byte key, got %d bytes", KeySize, len(key))
+	}
+	return key, nil
+}
+
+// IsEncrypted returns true if the supplied value was returned by Encrypt
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, Prefix)
+}
+
+// Encrypt encrypts the supplied plaintext with AES-GCM using the supplied key
+// and returns the base64-encoded nonce and ciphertext, prefixed with Prefix.
+func Encrypt(key []byte, plaintext string) (string, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+	sealed := gcm.Seal(nonce, nonce, []byte(plaintext), nil)
+	return Prefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt returns the plaintext of a value returned by Encrypt with the same
+// key. Values without the Prefix are returned unchanged, so that consumers
+// may decrypt fields regardless of whether they are encrypted.
+func Decrypt(key []byte, value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(value, Prefix))
+	if err != nil {
+		return "", fmt.Errorf("invalid encrypted value: %v", err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < gcm.NonceSize() {
+		return "", fmt.Errorf("invalid encrypted value: too short")
+	}
+	nonce, ciphertext := sealed[:gcm.NonceSize()], sealed[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("unable to decrypt value: %v", err)
+	}
+	return string(plaintext), nil
+}
+
+// newGCM returns an AES-GCM AEAD using the supplied key
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
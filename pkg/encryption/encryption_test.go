@@ -0,0 +1,63 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package encryption_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/encryption"
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	require := require.New(t)
+
+	key, err := encryption.ParseKey(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)))
+	require.Nil(err)
+
+	encrypted, err := encryption.Encrypt(key, "mydb.example.com")
+	require.Nil(err)
+	require.True(encryption.IsEncrypted(encrypted))
+	require.NotContains(encrypted, "mydb.example.com")
+
+	decrypted, err := encryption.Decrypt(key, encrypted)
+	require.Nil(err)
+	require.Equal("mydb.example.com", decrypted)
+
+	// Plaintext values are returned unchanged
+	decrypted, err = encryption.Decrypt(key, "mydb.example.com")
+	require.Nil(err)
+	require.Equal("mydb.example.com", decrypted)
+
+	// Values encrypted with another key cannot be decrypted
+	otherKey := bytes.Repeat([]byte{2}, 32)
+	_, err = encryption.Decrypt(otherKey, encrypted)
+	require.NotNil(err)
+}
+
+func TestParseKey(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := encryption.ParseKey("not base64!")
+	assert.NotNil(err)
+	_, err = encryption.ParseKey(base64.StdEncoding.EncodeToString([]byte("too short")))
+	assert.NotNil(err)
+	key, err := encryption.ParseKey(base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32)) + "\n")
+	assert.Nil(err)
+	assert.Len(key, 32)
+}
@@ -0,0 +1,54 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package errors
+
+import (
+	stderrors "errors"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// accessDeniedCodes are the codes of the AWS errors returned when the
+// credentials used by the controller are not allowed to make a request.
+var accessDeniedCodes = map[string]bool{
+	"AccessDenied":                    true,
+	"AccessDeniedException":           true,
+	"AuthorizationError":              true,
+	"AuthorizationErrorException":     true,
+	"UnauthorizedAccess":              true,
+	"UnauthorizedOperation":           true,
+	"UnauthorizedException":           true,
+	"NotAuthorized":                   true,
+	"NotAuthorizedException":          true,
+	"InsufficientPermissions":         true,
+	"InsufficientPrivilegesException": true,
+}
+
+// AccessDenied returns the AWS error wrapped by the supplied error if it
+// indicates that the request was denied by IAM, and true. Otherwise it
+// returns nil and false.
+func AccessDenied(err error) (awserr.Error, bool) {
+	var awsErr awserr.Error
+	if !stderrors.As(err, &awsErr) || !accessDeniedCodes[awsErr.Code()] {
+		return nil, false
+	}
+	return awsErr, true
+}
+
+// IsAccessDenied returns true if the supplied error is, or wraps, an AWS
+// error indicating that the request was denied by IAM.
+func IsAccessDenied(err error) bool {
+	_, ok := AccessDenied(err)
+	return ok
+}
@@ -15,6 +15,7 @@ package errors
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
 )
@@ -29,7 +30,10 @@ var (
 	// NotAdoptable is to indicate the current resource has been explicitly
 	// flagged as not able to be adopted
 	NotAdoptable = fmt.Errorf("resource not adoptable")
-	// NotImplemented is returned when a code path isn't implemented yet
+	// NotImplemented is returned when a code path isn't implemented yet.
+	// Optional resource manager methods, such as LateInitialize and
+	// EnsureTags, may return it to have the reconciler skip the
+	// corresponding phase, e.g. while the kind is not fully code-generated.
 	NotImplemented = fmt.Errorf("not implemented")
 	// NotFound is returned when an expected resource was not found
 	NotFound = fmt.Errorf("resource not found")
@@ -61,6 +65,33 @@ var (
 	// ReadOneFailedAfterCreate is returned if a ReadOne call fails right after
 	// a create operation.
 	ReadOneFailedAfterCreate = fmt.Errorf("ReadOne call failed after a Create operation")
+	// MissingRequiredAnnotation is returned when a resource lacks one of the
+	// annotations that the service controller is configured to require.
+	MissingRequiredAnnotation = fmt.Errorf("missing required annotation")
+	// ReadOnlyModeWrite is returned when the reconciler attempts an operation
+	// requiring write permissions on a resource reconciled in read-only mode.
+	// It indicates a misconfiguration of the controller rather than an AWS
+	// failure.
+	ReadOnlyModeWrite = fmt.Errorf("write operation attempted in read-only mode")
+	// UnresolvedAccount is returned when no AWS account could be resolved
+	// for a resource, because neither the resource, nor its namespace, nor
+	// the controller configuration specify one.
+	UnresolvedAccount = fmt.Errorf("no AWS account could be resolved for this resource")
+	// NamingPolicyViolation is returned when the name of a resource does not
+	// match the pattern that the service controller is configured to enforce
+	// for its kind.
+	NamingPolicyViolation = fmt.Errorf("resource name violates naming policy")
+	// CreateBudgetExhausted is returned when a resource cannot be created
+	// because the controller already manages as many resources of its kind as
+	// its create budget allows.
+	CreateBudgetExhausted = fmt.Errorf("create budget exhausted")
+	// RequiredFieldValueViolation is returned when the AWS resource of a
+	// resource lacks one of the field values that the service controller is
+	// configured to require.
+	RequiredFieldValueViolation = fmt.Errorf("AWS resource lacks required field values")
+	// PanicRecovered is returned when the reconciliation of a resource
+	// panicked and the panic was recovered.
+	PanicRecovered = fmt.Errorf("recovered from panic")
 )
 
 // AWSError returns the type conversion for the supplied error to an aws-sdk-go
@@ -77,6 +108,36 @@ func AWSRequestFailure(err error) (awserr.RequestFailure, bool) {
 	return awsRF, ok
 }
 
+// MissingRequiredAnnotationFor returns a MissingRequiredAnnotation error
+// naming the supplied missing annotation keys.
+func MissingRequiredAnnotationFor(keys ...string) error {
+	return fmt.Errorf("%w: %s", MissingRequiredAnnotation, strings.Join(keys, ", "))
+}
+
+// NamingPolicyViolationFor returns a NamingPolicyViolation error naming the
+// supplied resource name and the pattern it does not match.
+func NamingPolicyViolationFor(name string, pattern string) error {
+	return fmt.Errorf("%w: name %q does not match %q", NamingPolicyViolation, name, pattern)
+}
+
+// RequiredFieldValueViolationFor returns a RequiredFieldValueViolation error
+// naming the supplied field paths.
+func RequiredFieldValueViolationFor(paths ...string) error {
+	return fmt.Errorf("%w: %s", RequiredFieldValueViolation, strings.Join(paths, ", "))
+}
+
+// ReadOnlyModeWriteFor returns a ReadOnlyModeWrite error naming the supplied
+// write operation.
+func ReadOnlyModeWriteFor(operation string) error {
+	return fmt.Errorf("%w: %s", ReadOnlyModeWrite, operation)
+}
+
+// PanicRecoveredFor returns a PanicRecovered error carrying the supplied
+// value passed to panic.
+func PanicRecoveredFor(value interface{}) error {
+	return fmt.Errorf("%w: %v", PanicRecovered, value)
+}
+
 // NewReadOneFailAfterCreate takes a number of attempts and returns a
 // ReadOneFailedAfterCreate error if multiple ReadOne calls fails.
 func NewReadOneFailAfterCreate(numAttempts int) error {
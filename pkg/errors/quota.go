@@ -0,0 +1,55 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package errors
+
+import (
+	stderrors "errors"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+// quotaExceededCodes are the codes of the AWS errors returned when a service
+// quota or limit of the AWS account is reached.
+//
+// NOTE: RequestLimitExceeded is deliberately absent. EC2 returns it when
+// requests are throttled, which is unrelated to the account's quotas.
+var quotaExceededCodes = map[string]bool{
+	"LimitExceeded":                  true,
+	"LimitExceededException":         true,
+	"QuotaExceededException":         true,
+	"ServiceQuotaExceededException":  true,
+	"ResourceLimitExceeded":          true,
+	"ResourceLimitExceededException": true,
+	"TooManyBuckets":                 true,
+}
+
+// QuotaExceeded returns the AWS error wrapped by the supplied error if it
+// indicates that a service quota or limit of the AWS account was reached,
+// and true. Otherwise it returns nil and false.
+func QuotaExceeded(err error) (awserr.Error, bool) {
+	var awsErr awserr.Error
+	if !stderrors.As(err, &awsErr) || !quotaExceededCodes[awsErr.Code()] {
+		return nil, false
+	}
+	return awsErr, true
+}
+
+// IsQuotaExceeded returns true if the supplied error is, or wraps, an AWS
+// error indicating that a service quota or limit of the AWS account was
+// reached. Such errors are not resolved by retrying until the quota is
+// raised or resources are freed.
+func IsQuotaExceeded(err error) bool {
+	_, ok := QuotaExceeded(err)
+	return ok
+}
@@ -47,6 +47,36 @@ var (
 	ResourceReferenceMissingTargetField = fmt.Errorf(
 		"the referenced resource is missing the target field",
 	)
+	// ResourceReferenceCycle indicates that the resource directly or
+	// indirectly references itself, meaning none of the resources in the cycle
+	// can ever have their references resolved
+	ResourceReferenceCycle = fmt.Errorf(
+		"the resource references form a cycle",
+	)
+	// ResourceReferenceDifferentAccount indicates that the resource referred
+	// from AWSResourceReferenceWrapper is in a different AWS account than the
+	// referring resource
+	ResourceReferenceDifferentAccount = fmt.Errorf(
+		"the referenced resource is in a different account",
+	)
+	// ResourceReferenceDifferentRegion indicates that the resource referred
+	// from AWSResourceReferenceWrapper is in a different AWS region than the
+	// referring resource
+	ResourceReferenceDifferentRegion = fmt.Errorf(
+		"the referenced resource is in a different region",
+	)
+	// ResourceReferenceMismatch indicates that an attribute of the resource
+	// referred from AWSResourceReferenceWrapper is inconsistent with the
+	// fields of the referring resource, e.g. a subnet in a different VPC
+	ResourceReferenceMismatch = fmt.Errorf(
+		"the referenced resource does not match the referring resource",
+	)
+	// TooManyReferences indicates that resolving the references of a resource
+	// required more reads of referenced resources than the controller allows
+	// within a single reconciliation
+	TooManyReferences = fmt.Errorf(
+		"too many references",
+	)
 )
 
 // ResourceReferenceOrIDRequiredFor returns a ResourceReferenceOrIDRequired error
@@ -90,3 +120,47 @@ func ResourceReferenceMissingTargetFieldFor(resource string, namespace string,
 		", targetField:%s", ResourceReferenceMissingTargetField,
 		resource, namespace, name, targetField)
 }
+
+// ResourceReferenceCycleFor returns a ResourceReferenceCycle for the supplied
+// chain of resources, starting and ending with the same resource
+func ResourceReferenceCycleFor(cycle ...string) error {
+	return fmt.Errorf("%w: %s", ResourceReferenceCycle,
+		strings.Join(cycle, " -> "))
+}
+
+// ResourceReferenceMismatchFor returns a ResourceReferenceMismatch error for
+// the supplied referenced resource and field of the referring resource, with
+// the value of the field and the value expected by the referenced resource
+func ResourceReferenceMismatchFor(reference string, field string,
+	value string, expected string,
+) error {
+	return fmt.Errorf("%w. reference:%s, field:%s, value:%s, expected value:%s",
+		ResourceReferenceMismatch, reference, field, value, expected)
+}
+
+// TooManyReferencesFor returns a TooManyReferences error for the supplied
+// limit of reads of referenced resources
+func TooManyReferencesFor(limit int) error {
+	return fmt.Errorf("%w: resolving the references requires more than %d "+
+		"reads of referenced resources", TooManyReferences, limit)
+}
+
+// ResourceReferenceDifferentAccountFor returns a
+// ResourceReferenceDifferentAccount error for the supplied referenced
+// resource and accounts
+func ResourceReferenceDifferentAccountFor(reference string, account string,
+	expected string,
+) error {
+	return fmt.Errorf("%w. reference:%s, account:%s, expected account:%s",
+		ResourceReferenceDifferentAccount, reference, account, expected)
+}
+
+// ResourceReferenceDifferentRegionFor returns a
+// ResourceReferenceDifferentRegion error for the supplied referenced resource
+// and regions
+func ResourceReferenceDifferentRegionFor(reference string, region string,
+	expected string,
+) error {
+	return fmt.Errorf("%w. reference:%s, region:%s, expected region:%s",
+		ResourceReferenceDifferentRegion, reference, region, expected)
+}
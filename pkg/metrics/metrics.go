@@ -15,12 +15,22 @@ package metrics
 
 import (
 	"strconv"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 )
 
+const (
+	// OperationOutcomeSuccess is the outcome of a resource manager operation
+	// that succeeded or asked for the resource to be requeued
+	OperationOutcomeSuccess = "success"
+	// OperationOutcomeError is the outcome of a resource manager operation
+	// that failed
+	OperationOutcomeError = "error"
+)
+
 var (
 	outboundAPIRequestsTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
@@ -44,6 +54,145 @@ var (
 			"status_code",
 		},
 	)
+	reconcileErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ack_reconcile_errors_total",
+			Help: "Total number of reconciliations that failed, by resource kind and the operation that failed.",
+		},
+		[]string{
+			"service",
+			"kind",
+			"operation",
+			"controller_instance",
+		},
+	)
+	reconcilesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ack_reconciles_total",
+			Help: "Total number of reconciliations, by resource kind.",
+		},
+		[]string{
+			"service",
+			"kind",
+			"controller_instance",
+		},
+	)
+	leader = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ack_leader",
+			Help: "Whether this controller instance is the elected leader reconciling resources (1) or not (0).",
+		},
+		[]string{
+			"service",
+			"controller_instance",
+		},
+	)
+	orphanedResourcesTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ack_orphaned_resources_total",
+			Help: "Total number of resources whose ACK finalizer was removed before their AWS resource was deleted.",
+		},
+		[]string{
+			"service",
+			"kind",
+		},
+	)
+	referenceReadsPerReconcile = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ack_reference_reads_per_reconcile",
+			Help:    "Number of referenced resources read from the Kubernetes API server while resolving the references of a resource.",
+			Buckets: []float64{0, 1, 2, 5, 10, 25, 50, 100, 250, 500, 1000},
+		},
+		[]string{
+			"service",
+			"kind",
+		},
+	)
+	quotaExceededTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ack_quota_exceeded_total",
+			Help: "Total number of reconciliations that failed because a service quota or limit of the AWS account was reached.",
+		},
+		[]string{
+			"service",
+			"kind",
+		},
+	)
+	panicRecoveredTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ack_panic_recovered_total",
+			Help: "Total number of reconciliations that panicked and were recovered.",
+		},
+		[]string{
+			"service",
+			"kind",
+		},
+	)
+	estimatedMonthlyCost = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ack_estimated_monthly_cost_dollars",
+			Help: "Estimated monthly cost, in US dollars, of the AWS resources managed by the controller, by resource kind and AWS account.",
+		},
+		[]string{
+			"service",
+			"kind",
+			"account",
+		},
+	)
+	permissionRegression = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ack_permission_regression",
+			Help: "Whether the reconciliations of many resources of a kind are currently denied by IAM, indicating a regression of the controller's permissions (1) or not (0).",
+		},
+		[]string{
+			"service",
+			"kind",
+		},
+	)
+	inflightReconciles = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ack_inflight_reconciles",
+			Help: "Number of reconciliations currently in progress.",
+		},
+		[]string{
+			"service",
+			"kind",
+		},
+	)
+	operationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ack_resource_operations_total",
+			Help: "Total number of create, update and delete operations performed against AWS resources, by outcome.",
+		},
+		[]string{
+			"service",
+			"kind",
+			"operation",
+			"outcome",
+		},
+	)
+	operationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ack_resource_manager_operation_duration_seconds",
+			Help:    "Duration, in seconds, of the resource manager operations (e.g. ReadOne, Create, Update) performed while reconciling a resource.",
+			Buckets: []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+		},
+		[]string{
+			"service",
+			"kind",
+			"operation",
+		},
+	)
+	backpressureFactor = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "ack_backpressure_factor",
+			Help: "Factor by which requeue intervals are currently lengthened because of slow Kubernetes API server patches. 1 means no backpressure.",
+		},
+		[]string{
+			"service",
+			"kind",
+		},
+	)
 )
 
 // Metrics contains the set of Prometheus metric objects used to store counter
@@ -51,6 +200,10 @@ var (
 type Metrics struct {
 	// serviceID is the ID of the AWS service the controller is managing
 	serviceID string
+	// instance is the identity of the controller instance, e.g. its pod name,
+	// added as the controller_instance label of the reconcile metrics. It is
+	// empty unless set with SetInstance.
+	instance string
 	// obAPIRequestTotal contains the total number of outbound AWS API requests
 	// made by the service controller
 	obAPIRequestTotal *prometheus.CounterVec
@@ -58,6 +211,45 @@ type Metrics struct {
 	// requests made by the service controller that resulted in an HTTP 4XX or
 	// 5XX status code
 	obAPIRequestErrorTotal *prometheus.CounterVec
+	// reconcileErrorTotal contains the total number of failed
+	// reconciliations, labeled with the operation (create, update or delete)
+	// that failed
+	reconcileErrorTotal *prometheus.CounterVec
+	// reconcileTotal contains the total number of reconciliations
+	reconcileTotal *prometheus.CounterVec
+	// leader contains whether the controller instance is the elected leader
+	leader *prometheus.GaugeVec
+	// orphanedResourceTotal contains the total number of resources whose ACK
+	// finalizer was removed out of band, potentially orphaning their AWS
+	// resource
+	orphanedResourceTotal *prometheus.CounterVec
+	// quotaExceededTotal contains the total number of reconciliations that
+	// failed because a service quota or limit of the AWS account was reached
+	quotaExceededTotal *prometheus.CounterVec
+	// backpressureFactor contains the factor by which requeue intervals are
+	// currently lengthened because of slow Kubernetes API server patches
+	backpressureFactor *prometheus.GaugeVec
+	// permissionRegression contains whether a regression of the controller's
+	// permissions is detected for each resource kind
+	permissionRegression *prometheus.GaugeVec
+	// estimatedMonthlyCost contains the total estimated monthly cost of the
+	// AWS resources of each kind and AWS account
+	estimatedMonthlyCost *prometheus.GaugeVec
+	// referenceReads contains the distribution of the number of referenced
+	// resources read while resolving the references of a resource
+	referenceReads *prometheus.HistogramVec
+	// operationDuration contains the distribution of the durations of the
+	// resource manager operations, labeled with the operation name
+	operationDuration *prometheus.HistogramVec
+	// operationTotal contains the total number of create, update and delete
+	// operations, labeled with the operation and its outcome
+	operationTotal *prometheus.CounterVec
+	// inflightReconciles contains the number of reconciliations currently in
+	// progress
+	inflightReconciles *prometheus.GaugeVec
+	// panicRecovered contains the total number of reconciliations that
+	// panicked and were recovered
+	panicRecovered *prometheus.CounterVec
 }
 
 // RecordAPICall increments appropriate metrics tracking the count and duration
@@ -89,6 +281,238 @@ func (m *Metrics) RecordAPICall(
 	}
 }
 
+// RecordReconcileError increments the metric tracking the number of failed
+// reconciliations for the supplied resource kind and operation
+func (m *Metrics) RecordReconcileError(
+	// The kind of the resource being reconciled, e.g. "Bucket"
+	kind string,
+	// The operation that failed, e.g. "create", "update" or "delete"
+	operation string,
+) {
+	m.reconcileErrorTotal.With(
+		prometheus.Labels{
+			"service":             m.serviceID,
+			"kind":                kind,
+			"operation":           operation,
+			"controller_instance": m.instance,
+		},
+	).Inc()
+}
+
+// RecordReconcile increments the metric tracking the number of
+// reconciliations of resources of the supplied kind
+func (m *Metrics) RecordReconcile(
+	// The kind of the resource being reconciled, e.g. "Bucket"
+	kind string,
+) {
+	m.reconcileTotal.With(
+		prometheus.Labels{
+			"service":             m.serviceID,
+			"kind":                kind,
+			"controller_instance": m.instance,
+		},
+	).Inc()
+}
+
+// RecordLeader sets the metric tracking whether the controller instance is
+// the elected leader
+func (m *Metrics) RecordLeader(
+	// Whether the controller instance is the elected leader
+	isLeader bool,
+) {
+	value := 0.0
+	if isLeader {
+		value = 1
+	}
+	m.leader.With(
+		prometheus.Labels{
+			"service":             m.serviceID,
+			"controller_instance": m.instance,
+		},
+	).Set(value)
+}
+
+// SetInstance sets the identity of the controller instance, e.g. its pod
+// name, added as the controller_instance label of the reconcile metrics
+// recorded from then on.
+func (m *Metrics) SetInstance(instance string) {
+	m.instance = instance
+}
+
+// RecordOrphanedResource increments the metric tracking the number of
+// resources of the supplied kind whose ACK finalizer was removed before their
+// AWS resource was deleted
+func (m *Metrics) RecordOrphanedResource(
+	// The kind of the resource, e.g. "Bucket"
+	kind string,
+) {
+	m.orphanedResourceTotal.With(
+		prometheus.Labels{
+			"service": m.serviceID,
+			"kind":    kind,
+		},
+	).Inc()
+}
+
+// RecordQuotaExceeded increments the metric tracking the number of
+// reconciliations of resources of the supplied kind that failed because a
+// service quota or limit of the AWS account was reached
+func (m *Metrics) RecordQuotaExceeded(
+	// The kind of the resource, e.g. "Bucket"
+	kind string,
+) {
+	m.quotaExceededTotal.With(
+		prometheus.Labels{
+			"service": m.serviceID,
+			"kind":    kind,
+		},
+	).Inc()
+}
+
+// RecordPanicRecovered increments the number of reconciliations of resources
+// of the supplied kind that panicked and were recovered
+func (m *Metrics) RecordPanicRecovered(
+	// The kind of the resource, e.g. "Bucket"
+	kind string,
+) {
+	m.panicRecovered.With(
+		prometheus.Labels{
+			"service": m.serviceID,
+			"kind":    kind,
+		},
+	).Inc()
+}
+
+// RecordBackpressureFactor sets the metric tracking the factor by which the
+// requeue intervals of the supplied resource kind are currently lengthened
+func (m *Metrics) RecordBackpressureFactor(
+	// The kind of the resource, e.g. "Bucket"
+	kind string,
+	// The factor applied to requeue intervals, 1 meaning no backpressure
+	factor float64,
+) {
+	m.backpressureFactor.With(
+		prometheus.Labels{
+			"service": m.serviceID,
+			"kind":    kind,
+		},
+	).Set(factor)
+}
+
+// RecordPermissionRegression sets the metric tracking whether a regression of
+// the controller's permissions is detected for the supplied resource kind
+func (m *Metrics) RecordPermissionRegression(
+	// The kind of the resources, e.g. "Bucket"
+	kind string,
+	// Whether the reconciliations of many resources of the kind are denied
+	detected bool,
+) {
+	value := 0.0
+	if detected {
+		value = 1
+	}
+	m.permissionRegression.With(
+		prometheus.Labels{
+			"service": m.serviceID,
+			"kind":    kind,
+		},
+	).Set(value)
+}
+
+// RecordEstimatedMonthlyCost sets the metric tracking the total estimated
+// monthly cost of the AWS resources of the supplied kind in the supplied AWS
+// account
+func (m *Metrics) RecordEstimatedMonthlyCost(
+	// The kind of the resources, e.g. "Bucket"
+	kind string,
+	// The ID of the AWS account owning the resources
+	account string,
+	// The total estimated monthly cost of the resources, in US dollars
+	cost float64,
+) {
+	m.estimatedMonthlyCost.With(
+		prometheus.Labels{
+			"service": m.serviceID,
+			"kind":    kind,
+			"account": account,
+		},
+	).Set(cost)
+}
+
+// RecordReferenceReads observes the number of referenced resources read from
+// the Kubernetes API server while resolving the references of a resource of
+// the supplied kind
+func (m *Metrics) RecordReferenceReads(
+	// The kind of the resource, e.g. "Bucket"
+	kind string,
+	// The number of Get and List calls made while resolving references
+	reads int,
+) {
+	m.referenceReads.With(
+		prometheus.Labels{
+			"service": m.serviceID,
+			"kind":    kind,
+		},
+	).Observe(float64(reads))
+}
+
+// RecordOperationDuration observes the duration of a resource manager
+// operation performed while reconciling a resource of the supplied kind
+func (m *Metrics) RecordOperationDuration(
+	// The kind of the resource, e.g. "Bucket"
+	kind string,
+	// The name of the resource manager operation, e.g. "ReadOne"
+	operation string,
+	// The time taken by the operation
+	duration time.Duration,
+) {
+	m.operationDuration.With(
+		prometheus.Labels{
+			"service":   m.serviceID,
+			"kind":      kind,
+			"operation": operation,
+		},
+	).Observe(duration.Seconds())
+}
+
+// RecordOperation increments the number of create, update or delete
+// operations performed against AWS resources of the supplied kind
+func (m *Metrics) RecordOperation(
+	// The kind of the resource, e.g. "Bucket"
+	kind string,
+	// The operation performed, e.g. "create"
+	operation string,
+	// The outcome of the operation, OperationOutcomeSuccess or
+	// OperationOutcomeError
+	outcome string,
+) {
+	m.operationTotal.With(
+		prometheus.Labels{
+			"service":   m.serviceID,
+			"kind":      kind,
+			"operation": operation,
+			"outcome":   outcome,
+		},
+	).Inc()
+}
+
+// AddInflightReconciles adds the supplied delta, 1 when a reconciliation
+// starts and -1 when it returns, to the number of reconciliations of
+// resources of the supplied kind currently in progress
+func (m *Metrics) AddInflightReconciles(
+	// The kind of the resource, e.g. "Bucket"
+	kind string,
+	// The change in the number of reconciliations in progress
+	delta float64,
+) {
+	m.inflightReconciles.With(
+		prometheus.Labels{
+			"service": m.serviceID,
+			"kind":    kind,
+		},
+	).Add(delta)
+}
+
 // Collectors simply provides an iterator over the `prometheus.Collector`
 // interface pointers of the underlying metrics. This allows a
 // `prometheus.Registerer` (like controller-runtime's metrics.Registry) to
@@ -97,6 +521,19 @@ func (m *Metrics) Collectors() []prometheus.Collector {
 	return []prometheus.Collector{
 		m.obAPIRequestTotal,
 		m.obAPIRequestErrorTotal,
+		m.reconcileErrorTotal,
+		m.reconcileTotal,
+		m.leader,
+		m.orphanedResourceTotal,
+		m.quotaExceededTotal,
+		m.backpressureFactor,
+		m.permissionRegression,
+		m.estimatedMonthlyCost,
+		m.referenceReads,
+		m.operationDuration,
+		m.operationTotal,
+		m.inflightReconciles,
+		m.panicRecovered,
 	}
 }
 
@@ -107,5 +544,18 @@ func NewMetrics(serviceID string) *Metrics {
 		serviceID:              serviceID,
 		obAPIRequestTotal:      outboundAPIRequestsTotal,
 		obAPIRequestErrorTotal: outboundAPIRequestsErrorTotal,
+		reconcileErrorTotal:    reconcileErrorsTotal,
+		reconcileTotal:         reconcilesTotal,
+		leader:                 leader,
+		orphanedResourceTotal:  orphanedResourcesTotal,
+		quotaExceededTotal:     quotaExceededTotal,
+		backpressureFactor:     backpressureFactor,
+		estimatedMonthlyCost:   estimatedMonthlyCost,
+		permissionRegression:   permissionRegression,
+		referenceReads:         referenceReadsPerReconcile,
+		operationDuration:      operationDuration,
+		operationTotal:         operationsTotal,
+		inflightReconciles:     inflightReconciles,
+		panicRecovered:         panicRecoveredTotal,
 	}
 }
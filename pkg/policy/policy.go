@@ -0,0 +1,103 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package policy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+// Input is the document sent to the policy endpoint, wrapped in an "input"
+// field as expected by the Open Policy Agent data API.
+type Input struct {
+	// Operation is the mutating operation about to be performed against the
+	// backend AWS resource, e.g. "create" or "update"
+	Operation string `json:"operation"`
+	// Kind is the kind of the resource, e.g. "Bucket"
+	Kind string `json:"kind"`
+	// Resource is the desired state of the custom resource
+	Resource interface{} `json:"resource"`
+}
+
+// Decision is the result returned by the policy endpoint.
+type Decision struct {
+	// Allow is true if the operation is allowed by the policies
+	Allow bool `json:"allow"`
+	// Reason explains why the operation is denied
+	Reason string `json:"reason,omitempty"`
+}
+
+// Checker sends resources to an Open Policy Agent (or compatible webhook)
+// endpoint to decide whether mutating operations against them are allowed.
+//
+// The endpoint receives a POST request with a JSON body of the form
+// {"input": Input} and must respond with a JSON body of the form
+// {"result": Decision}.
+type Checker struct {
+	endpoint string
+	client   *http.Client
+}
+
+// NewChecker returns a Checker sending requests to the supplied endpoint,
+// e.g. "http://opa:8181/v1/data/ack/allow", with the supplied timeout.
+func NewChecker(endpoint string, timeout time.Duration) *Checker {
+	return &Checker{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+// Check returns the policy decision for the supplied input. An error is
+// returned if the endpoint could not be reached or returned an invalid
+// response, in which case no decision could be made.
+func (c *Checker) Check(ctx context.Context, input Input) (*Decision, error) {
+	body, err := json.Marshal(struct {
+		Input Input `json:"input"`
+	}{input})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("policy endpoint returned status %d: %s", resp.StatusCode, respBody)
+	}
+	var result struct {
+		Result *Decision `json:"result"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return nil, fmt.Errorf("invalid policy endpoint response: %v", err)
+	}
+	if result.Result == nil {
+		return nil, fmt.Errorf("policy endpoint returned no result")
+	}
+	return result.Result, nil
+}
@@ -0,0 +1,76 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package policy_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/policy"
+)
+
+func TestCheck(t *testing.T) {
+	require := require.New(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Input policy.Input `json:"input"`
+		}
+		require.Nil(json.NewDecoder(r.Body).Decode(&body))
+		if body.Input.Kind == "Bucket" {
+			w.Write([]byte(`{"result": {"allow": false, "reason": "public buckets are not allowed"}}`))
+			return
+		}
+		w.Write([]byte(`{"result": {"allow": true}}`))
+	}))
+	defer server.Close()
+
+	checker := policy.NewChecker(server.URL, time.Second)
+
+	decision, err := checker.Check(context.TODO(), policy.Input{Operation: "create", Kind: "Bucket"})
+	require.Nil(err)
+	assert.False(t, decision.Allow)
+	assert.Equal(t, "public buckets are not allowed", decision.Reason)
+
+	decision, err = checker.Check(context.TODO(), policy.Input{Operation: "create", Kind: "Topic"})
+	require.Nil(err)
+	assert.True(t, decision.Allow)
+}
+
+func TestCheck_InvalidResponse(t *testing.T) {
+	for _, response := range []struct {
+		status int
+		body   string
+	}{
+		{http.StatusInternalServerError, `{"result": {"allow": true}}`},
+		{http.StatusOK, `not json`},
+		{http.StatusOK, `{}`},
+	} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(response.status)
+			w.Write([]byte(response.body))
+		}))
+		checker := policy.NewChecker(server.URL, time.Second)
+		_, err := checker.Check(context.TODO(), policy.Input{})
+		assert.NotNil(t, err)
+		server.Close()
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"sync"
+	"time"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+// accessDeniedDetector tells a regression of the controller's own
+// permissions, which causes the reconciliations of many resources of a kind
+// to be denied by IAM, apart from isolated access denied errors, e.g. caused
+// by the resource-based policy of a single AWS resource.
+//
+// A regression is detected once the latest reconciliations of
+// --access-denied-threshold distinct resources, within
+// --access-denied-window-seconds, were denied. It is resolved once a
+// resource is reconciled without being denied and fewer resources than the
+// threshold remain denied.
+type accessDeniedDetector struct {
+	sync.Mutex
+	threshold int
+	window    time.Duration
+	pause     time.Duration
+	// denied maps the resources whose latest reconciliation was denied to
+	// the time of that reconciliation
+	denied map[k8stypes.NamespacedName]time.Time
+	// detected is true while a regression is detected
+	detected bool
+	// pausedUntil is the time until which reconciliations are paused
+	pausedUntil time.Time
+}
+
+// newAccessDeniedDetector returns an accessDeniedDetector with the supplied
+// threshold, window and pause
+func newAccessDeniedDetector(
+	threshold int,
+	window time.Duration,
+	pause time.Duration,
+) *accessDeniedDetector {
+	return &accessDeniedDetector{
+		threshold: threshold,
+		window:    window,
+		pause:     pause,
+		denied:    map[k8stypes.NamespacedName]time.Time{},
+	}
+}
+
+// observe records whether the reconciliation of the supplied resource at the
+// supplied time was denied. It returns the number of resources currently
+// denied, whether a regression is detected, and whether the detection
+// changed.
+func (d *accessDeniedDetector) observe(
+	nn k8stypes.NamespacedName,
+	denied bool,
+	now time.Time,
+) (int, bool, bool) {
+	d.Lock()
+	defer d.Unlock()
+	if denied {
+		d.denied[nn] = now
+	} else {
+		delete(d.denied, nn)
+	}
+	for other, t := range d.denied {
+		if now.Sub(t) > d.window {
+			delete(d.denied, other)
+		}
+	}
+	count := len(d.denied)
+	switch {
+	case count >= d.threshold && (!d.detected || !now.Before(d.pausedUntil)):
+		// Pause again if the regression persists after the pause.
+		changed := !d.detected
+		d.detected = true
+		if d.pause > 0 {
+			d.pausedUntil = now.Add(d.pause)
+		}
+		return count, true, changed
+	case d.detected && !denied && count < d.threshold:
+		d.detected = false
+		d.pausedUntil = time.Time{}
+		return count, false, true
+	}
+	return count, d.detected, false
+}
+
+// remainingPause returns how long reconciliations remain paused at the
+// supplied time.
+func (d *accessDeniedDetector) remainingPause(now time.Time) time.Duration {
+	d.Lock()
+	defer d.Unlock()
+	if remaining := d.pausedUntil.Sub(now); remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// accessDeniedPause returns how long the reconciliation of the resources of
+// the reconciler's kind remains paused because of a regression of the
+// controller's permissions.
+func (r *resourceReconciler) accessDeniedPause() time.Duration {
+	if r.accessDenied == nil {
+		return 0
+	}
+	return r.accessDenied.remainingPause(time.Now())
+}
+
+// observeAccessDenied records whether the reconciliation of the supplied
+// resource failed with an access denied error, and reports regressions of
+// the controller's permissions with an error log and the
+// ack_permission_regression metric.
+func (r *resourceReconciler) observeAccessDenied(
+	nn k8stypes.NamespacedName,
+	err error,
+) {
+	if r.accessDenied == nil {
+		return
+	}
+	awsErr, denied := ackerr.AccessDenied(err)
+	count, detected, changed := r.accessDenied.observe(nn, denied, time.Now())
+	if !changed {
+		return
+	}
+	kind := r.rd.GroupKind().Kind
+	r.metrics.RecordPermissionRegression(kind, detected)
+	if detected {
+		r.log.Error(
+			awsErr,
+			"reconciliations of many resources denied by IAM, the controller's permissions may have regressed",
+			"kind", kind,
+			"denied_resources", count,
+			"pause", r.accessDenied.pause,
+		)
+	} else {
+		r.log.Info(
+			"reconciliations no longer denied by IAM, the controller's permissions were restored",
+			"kind", kind,
+		)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// isAdoptionObserved returns true if the supplied resource has the
+// `services.k8s.aws/adoption-observed` annotation set to "true"
+func isAdoptionObserved(res acktypes.AWSResource) bool {
+	value, ok := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationAdoptionObserved]
+	return ok && strings.ToLower(value) == "true"
+}
+
+// observeAdoptedResource returns true if the update of the supplied adopted
+// resource must be skipped because this is the first reconciliation of the
+// resource since it was adopted and the controller runs in observe-first
+// adoption mode. In that case, the resource is marked as observed so that its
+// Spec is enforced from the next reconciliation on.
+func (r *resourceReconciler) observeAdoptedResource(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+) (bool, error) {
+	if !r.cfg.AdoptionObserveFirst || !IsAdopted(desired) || isAdoptionObserved(desired) {
+		return false, nil
+	}
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("r.observeAdoptedResource")
+	defer func() {
+		exit(err)
+	}()
+
+	res := desired.DeepCopy()
+	orig := res.DeepCopy().RuntimeObject()
+	annotations := res.MetaObject().GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ackv1alpha1.AnnotationAdoptionObserved] = "true"
+	res.MetaObject().SetAnnotations(annotations)
+	if err = r.kc.Patch(ctx, res.RuntimeObject(), client.MergeFrom(orig)); err != nil {
+		return false, err
+	}
+
+	// Keep the annotation on the latest observed state so that later patches
+	// of the resource do not remove it.
+	annotations = latest.MetaObject().GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ackv1alpha1.AnnotationAdoptionObserved] = "true"
+	latest.MetaObject().SetAnnotations(annotations)
+	return true, nil
+}
@@ -148,13 +148,7 @@ func (r *adoptionReconciler) Sync(
 	rm acktypes.AWSResourceManager,
 	desired *ackv1alpha1.AdoptedResource,
 ) error {
-	// Create empty resource with spec/status fields set for ReadOne
-	readableResource := targetDescriptor.ResourceFromRuntimeObject(targetDescriptor.EmptyRuntimeObject())
-	if err := readableResource.SetIdentifiers(desired.Spec.AWS); err != nil {
-		return r.onError(ctx, desired, err)
-	}
-
-	described, err := rm.ReadOne(ctx, readableResource)
+	described, err := r.describeAdoptedResource(ctx, targetDescriptor, rm, desired)
 	if err != nil {
 		return r.onError(ctx, desired, err)
 	}
@@ -213,7 +207,7 @@ func (r *adoptionReconciler) Sync(
 	}
 
 	described.SetObjectMeta(*targetMeta)
-	targetDescriptor.MarkManaged(described)
+	markManaged(targetDescriptor, r.cfg.FinalizerOverride, described)
 	targetDescriptor.MarkAdopted(described)
 
 	// Only create the described resource if it does not already exist
@@ -255,6 +249,27 @@ func (r *adoptionReconciler) Sync(
 
 // cleanup removes the finalizer from AdoptedResource so that k8s object can
 // be deleted.
+// describeAdoptedResource returns the currently-observed state of the backend
+// AWS resource adopted by the supplied AdoptedResource. Resource managers
+// implementing AWSResourceAdoptionMatcher locate the AWS resource themselves;
+// otherwise it is read using the AdoptedResource's AWS identifiers.
+func (r *adoptionReconciler) describeAdoptedResource(
+	ctx context.Context,
+	targetDescriptor acktypes.AWSResourceDescriptor,
+	rm acktypes.AWSResourceManager,
+	desired *ackv1alpha1.AdoptedResource,
+) (acktypes.AWSResource, error) {
+	if matcher, ok := rm.(acktypes.AWSResourceAdoptionMatcher); ok {
+		return matcher.MatchAdoptedResource(ctx, desired)
+	}
+	// Create empty resource with spec/status fields set for ReadOne
+	readableResource := targetDescriptor.ResourceFromRuntimeObject(targetDescriptor.EmptyRuntimeObject())
+	if err := readableResource.SetIdentifiers(desired.Spec.AWS); err != nil {
+		return nil, err
+	}
+	return rm.ReadOne(ctx, readableResource)
+}
+
 func (r *adoptionReconciler) cleanup(
 	ctx context.Context,
 	current *ackv1alpha1.AdoptedResource,
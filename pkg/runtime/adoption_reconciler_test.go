@@ -388,6 +388,51 @@ func TestSync_HappyCase(t *testing.T) {
 	assertAdoptedCondition("True", require, t, ctx, kc, statusWriter, adoptedRes)
 }
 
+// adoptionMatcherManager is an AWSResourceManager locating adopted resources
+// itself
+type adoptionMatcherManager struct {
+	*ackmocks.AWSResourceManager
+	matched acktypes.AWSResource
+}
+
+func (rm *adoptionMatcherManager) MatchAdoptedResource(
+	ctx context.Context,
+	adopted *ackv1alpha1.AdoptedResource,
+) (acktypes.AWSResource, error) {
+	return rm.matched, nil
+}
+
+func TestSync_AdoptionMatcher(t *testing.T) {
+	// Setup
+	require := require.New(t)
+	// Mock resource creation
+	r, kc, apiReader := mockAdoptionReconciler()
+	descriptor, res, resDeepCopy := mockDescriptorAndAWSResource()
+	manager := mockManager()
+	adoptedRes := adoptedResource(AdoptedResourceNamespace, AdoptedResourceName)
+	ctx := context.TODO()
+	statusWriter := &ctrlrtclientmock.SubResourceWriter{}
+
+	//Mock behavior setup
+	setupMockAwsResource(res, resDeepCopy, adoptedRes)
+	setupMockClientForAdoptedResource(kc, statusWriter, ctx, adoptedRes)
+	setupMockDescriptor(descriptor, res)
+	setupMockApiReaderForAdoptedResource(apiReader, ctx, res)
+	kc.On("Create", ctx, res.RuntimeObject()).Return(nil)
+	statusWriter.On("Update", ctx, res.RuntimeObject()).Return(nil)
+
+	// Call
+	err := r.Sync(ctx, descriptor, &adoptionMatcherManager{manager, res}, adoptedRes)
+
+	//Assertions
+	require.Nil(err)
+	res.AssertNotCalled(t, "SetIdentifiers", adoptedRes.Spec.AWS)
+	manager.AssertNotCalled(t, "ReadOne", ctx, res)
+	assertAWSResourceCreation(true, t, ctx, kc, statusWriter, res, resDeepCopy)
+	assertAdoptedResourceManaged(true, t, ctx, kc, adoptedRes)
+	assertAdoptedCondition("True", require, t, ctx, kc, statusWriter, adoptedRes)
+}
+
 // Assertion Helpers
 
 // assertAdoptedCondition asserts that 'ConditionTypeAdopted' condition is
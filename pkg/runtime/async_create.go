@@ -0,0 +1,98 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// IsCreateInProgress returns true if the supplied AWSResource has the
+// services.k8s.aws/create-in-progress annotation, which indicates that its
+// AWS resource was created asynchronously and could not be read yet.
+func IsCreateInProgress(res acktypes.AWSResource) bool {
+	_, ok := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationCreateInProgress]
+	return ok
+}
+
+// pollsAsyncCreate returns true if the reconciler keeps checking whether the
+// AWS resources it created exist when they cannot be read right after being
+// created, according to the --async-create-poll-kinds flag.
+func (r *resourceReconciler) pollsAsyncCreate() bool {
+	return r.cfg.AsyncCreatePollEnabled(r.rd.GroupKind().Kind)
+}
+
+// markCreateInProgress records in the services.k8s.aws/create-in-progress
+// annotation that the AWS resource of the supplied newly-created resource
+// could not be read yet, so that it is not created again, and requeues the
+// resource to check again later.
+func (r *resourceReconciler) markCreateInProgress(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	annotations := latest.MetaObject().GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ackv1alpha1.AnnotationCreateInProgress] = time.Now().UTC().Format(time.RFC3339)
+	latest.MetaObject().SetAnnotations(annotations)
+	// Persist the identifiers set by the Create call along with the
+	// annotation, so that the AWS resource can be read later.
+	if err := r.patchResourceMetadataAndSpec(ctx, desired, latest); err != nil {
+		return latest, err
+	}
+	ackrtlog.FromContext(ctx).Info("AWS resource creation still in progress, polling")
+	return latest, r.requeueCreateInProgress(latest)
+}
+
+// requeueCreateInProgress sets the ACK.ResourceSynced condition of the
+// supplied resource, whose asynchronous creation is in progress, to False and
+// returns an error requeueing it after --async-create-poll-seconds.
+func (r *resourceReconciler) requeueCreateInProgress(
+	res acktypes.AWSResource,
+) error {
+	ackcondition.SetSynced(
+		res, corev1.ConditionFalse,
+		&ackcondition.NotSyncedMessage,
+		&ackcondition.CreateInProgressReason,
+	)
+	return requeue.NeededAfter(
+		nil, time.Duration(r.cfg.AsyncCreatePollSeconds)*time.Second,
+	)
+}
+
+// clearCreateInProgress removes the services.k8s.aws/create-in-progress
+// annotation from the supplied resource once its AWS resource was found.
+func (r *resourceReconciler) clearCreateInProgress(
+	ctx context.Context,
+	latest acktypes.AWSResource,
+) error {
+	if !IsCreateInProgress(latest) {
+		return nil
+	}
+	orig := latest.DeepCopy()
+	annotations := latest.MetaObject().GetAnnotations()
+	delete(annotations, ackv1alpha1.AnnotationCreateInProgress)
+	latest.MetaObject().SetAnnotations(annotations)
+	return r.patchResourceMetadataAndSpec(ctx, orig, latest)
+}
@@ -0,0 +1,181 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+const (
+	// BackoffBaseDelay is the backoff applied after the first failed
+	// reconciliation of a resource. It matches the base delay of
+	// controller-runtime's default rate limiter.
+	BackoffBaseDelay = 5 * time.Millisecond
+	// BackoffMaxDelay is the maximum backoff applied between two failed
+	// reconciliations of a resource. It matches the maximum delay of
+	// controller-runtime's default rate limiter.
+	BackoffMaxDelay = 1000 * time.Second
+)
+
+// BackoffState is the reconcile state persisted in the
+// services.k8s.aws/backoff-state annotation of resources whose
+// reconciliations keep failing.
+type BackoffState struct {
+	// ConsecutiveFailures is the number of reconciliations of the resource
+	// that failed since it was last reconciled successfully
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+	// LastBackoff is the backoff applied after the last failure
+	LastBackoff metav1.Duration `json:"lastBackoff"`
+	// RetryAfter is the time before which the resource is not reconciled
+	// again, unless its generation changes
+	RetryAfter metav1.Time `json:"retryAfter"`
+	// Generation is the generation of the resource when it last failed to
+	// reconcile
+	Generation int64 `json:"generation"`
+}
+
+// ComputeBackoffState returns the backoff state of a resource after a
+// reconciliation, given the state before the reconciliation (nil if the
+// resource had no backoff state), the generation of the resource and the
+// error returned by the reconciliation. It returns nil once a reconciliation
+// succeeds, becomes terminal or merely requests a requeue.
+//
+// The backoff doubles with every consecutive failure, starting at
+// BackoffBaseDelay and capped at BackoffMaxDelay.
+func ComputeBackoffState(
+	prior *BackoffState,
+	generation int64,
+	err error,
+	now time.Time,
+) *BackoffState {
+	if _, resultErr := ResultForError(err); resultErr == nil {
+		return nil
+	}
+	failures := 1
+	if prior != nil {
+		failures = prior.ConsecutiveFailures + 1
+	}
+	backoff := BackoffBaseDelay
+	for i := 1; i < failures && backoff < BackoffMaxDelay; i++ {
+		backoff *= 2
+	}
+	if backoff > BackoffMaxDelay {
+		backoff = BackoffMaxDelay
+	}
+	return &BackoffState{
+		ConsecutiveFailures: failures,
+		LastBackoff:         metav1.Duration{Duration: backoff},
+		RetryAfter:          metav1.NewTime(now.Add(backoff)),
+		Generation:          generation,
+	}
+}
+
+// getBackoffState returns the backoff state persisted in the annotations of
+// the supplied resource, or nil if the resource has no (valid) backoff state.
+func getBackoffState(res acktypes.AWSResource) *BackoffState {
+	val, ok := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationBackoffState]
+	if !ok {
+		return nil
+	}
+	state := &BackoffState{}
+	if err := json.Unmarshal([]byte(val), state); err != nil {
+		// Values that cannot be parsed, e.g. because users edited them,
+		// restart the backoff from scratch.
+		return nil
+	}
+	return state
+}
+
+// remainingBackoff returns how long the reconciliation of the supplied
+// resource should still be delayed according to its persisted backoff state.
+// It returns zero if backoff persistence is disabled, the resource has no
+// backoff state or the resource was modified since it last failed.
+func (r *resourceReconciler) remainingBackoff(
+	res acktypes.AWSResource,
+) time.Duration {
+	if !r.cfg.EnableBackoffPersistence {
+		return 0
+	}
+	state := getBackoffState(res)
+	if state == nil || state.Generation != res.MetaObject().GetGeneration() {
+		return 0
+	}
+	remaining := time.Until(state.RetryAfter.Time)
+	if remaining < 0 || remaining > BackoffMaxDelay {
+		return 0
+	}
+	return remaining
+}
+
+// updateBackoffState patches the backoff state annotation of the supplied
+// resource to reflect the outcome of the latest reconciliation.
+//
+// Failures to patch the annotation are logged and otherwise ignored. At worst,
+// a restarted controller retries the resource without any backoff.
+func (r *resourceReconciler) updateBackoffState(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	reconcileErr error,
+) {
+	if !r.cfg.EnableBackoffPersistence {
+		return
+	}
+	state := ComputeBackoffState(
+		getBackoffState(desired), desired.MetaObject().GetGeneration(),
+		reconcileErr, time.Now(),
+	)
+
+	res := desired.DeepCopy()
+	orig := res.DeepCopy().RuntimeObject()
+	annotations := res.MetaObject().GetAnnotations()
+	if state == nil {
+		if _, ok := annotations[ackv1alpha1.AnnotationBackoffState]; !ok {
+			return
+		}
+		delete(annotations, ackv1alpha1.AnnotationBackoffState)
+	} else {
+		val, err := json.Marshal(state)
+		if err != nil {
+			return
+		}
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[ackv1alpha1.AnnotationBackoffState] = string(val)
+	}
+	res.MetaObject().SetAnnotations(annotations)
+
+	equalMetadata, err := ackcompare.MetaV1ObjectEqual(
+		desired.MetaObject(), res.MetaObject(),
+	)
+	if err != nil || equalMetadata {
+		return
+	}
+	if err = r.kc.Patch(ctx, res.RuntimeObject(), client.MergeFrom(orig)); err != nil {
+		ackrtlog.FromContext(ctx).Debug(
+			"failed to update backoff state annotation",
+			"error", err,
+		)
+	}
+}
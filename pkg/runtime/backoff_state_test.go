@@ -0,0 +1,60 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestComputeBackoffState(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	now := time.Now()
+	failure := errors.New("boom")
+
+	// Successful, terminal and requeued reconciliations clear the state
+	prior := &ackrt.BackoffState{ConsecutiveFailures: 3}
+	assert.Nil(ackrt.ComputeBackoffState(prior, 1, nil, now))
+	assert.Nil(ackrt.ComputeBackoffState(prior, 1, ackerr.Terminal, now))
+	assert.Nil(ackrt.ComputeBackoffState(
+		prior, 1, requeue.NeededAfter(nil, time.Second), now,
+	))
+
+	state := ackrt.ComputeBackoffState(nil, 2, failure, now)
+	require.NotNil(state)
+	assert.Equal(1, state.ConsecutiveFailures)
+	assert.Equal(ackrt.BackoffBaseDelay, state.LastBackoff.Duration)
+	assert.Equal(now.Add(ackrt.BackoffBaseDelay), state.RetryAfter.Time)
+	assert.Equal(int64(2), state.Generation)
+
+	state = ackrt.ComputeBackoffState(state, 2, failure, now)
+	assert.Equal(2, state.ConsecutiveFailures)
+	assert.Equal(2*ackrt.BackoffBaseDelay, state.LastBackoff.Duration)
+
+	// The backoff is capped
+	state = ackrt.ComputeBackoffState(
+		&ackrt.BackoffState{ConsecutiveFailures: 100}, 2, failure, now,
+	)
+	assert.Equal(101, state.ConsecutiveFailures)
+	assert.Equal(ackrt.BackoffMaxDelay, state.LastBackoff.Duration)
+}
@@ -0,0 +1,113 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+)
+
+const (
+	// patchLatencyWeight is the weight given to the latest observed patch
+	// latency in the exponentially weighted moving average of the latencies
+	patchLatencyWeight = 0.2
+	// MaxBackpressureFactor is the largest factor by which requeue intervals
+	// are lengthened when the Kubernetes API server is slow
+	MaxBackpressureFactor = 8.0
+)
+
+// BackpressureFactor returns the factor by which requeue intervals should be
+// lengthened given the supplied average latency of the patches made to the
+// Kubernetes API server. The factor is the ratio of the average latency to
+// the threshold, between 1 and MaxBackpressureFactor. A zero threshold
+// disables backpressure.
+func BackpressureFactor(avgLatency time.Duration, threshold time.Duration) float64 {
+	if threshold <= 0 || avgLatency <= threshold {
+		return 1
+	}
+	factor := float64(avgLatency) / float64(threshold)
+	if factor > MaxBackpressureFactor {
+		return MaxBackpressureFactor
+	}
+	return factor
+}
+
+// patchLatencyTracker keeps a moving average of the latency of the patches
+// made to the Kubernetes API server by a reconciler.
+type patchLatencyTracker struct {
+	sync.Mutex
+	threshold  time.Duration
+	avgLatency time.Duration
+}
+
+// observe records the latency of a patch and returns the resulting
+// backpressure factor.
+func (t *patchLatencyTracker) observe(latency time.Duration) float64 {
+	t.Lock()
+	defer t.Unlock()
+	if t.avgLatency == 0 {
+		t.avgLatency = latency
+	} else {
+		t.avgLatency = time.Duration(
+			patchLatencyWeight*float64(latency) +
+				(1-patchLatencyWeight)*float64(t.avgLatency),
+		)
+	}
+	return BackpressureFactor(t.avgLatency, t.threshold)
+}
+
+// factor returns the current backpressure factor.
+func (t *patchLatencyTracker) factor() float64 {
+	t.Lock()
+	defer t.Unlock()
+	return BackpressureFactor(t.avgLatency, t.threshold)
+}
+
+// observePatchLatency records the latency of a patch made to the Kubernetes
+// API server and updates the backpressure metric accordingly.
+func (r *resourceReconciler) observePatchLatency(latency time.Duration) {
+	if r.patchLatency == nil {
+		return
+	}
+	factor := r.patchLatency.observe(latency)
+	if r.metrics != nil {
+		r.metrics.RecordBackpressureFactor(r.rd.GroupKind().Kind, factor)
+	}
+}
+
+// applyBackpressure lengthens the supplied requeue interval while the
+// Kubernetes API server is slow to apply patches, so that reconciliations do
+// not make things worse by sending even more patches.
+func (r *resourceReconciler) applyBackpressure(
+	ctx context.Context,
+	requeueAfter time.Duration,
+) time.Duration {
+	if r.patchLatency == nil || requeueAfter <= 0 {
+		return requeueAfter
+	}
+	factor := r.patchLatency.factor()
+	if factor <= 1 {
+		return requeueAfter
+	}
+	lengthened := time.Duration(float64(requeueAfter) * factor)
+	ackrtlog.FromContext(ctx).Debug(
+		"lengthening requeue interval because of slow apiserver patches",
+		"factor", factor,
+		"after", lengthened,
+	)
+	return lengthened
+}
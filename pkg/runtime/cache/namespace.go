@@ -36,6 +36,8 @@ type namespaceInfo struct {
 	endpointURL string
 	// {service}.services.k8s.aws/deletion-policy Annotations (keyed by service)
 	deletionPolicies map[string]string
+	// services.k8s.aws/read-only Annotation
+	readOnly bool
 }
 
 // getDefaultRegion returns the default region value
@@ -62,6 +64,14 @@ func (n *namespaceInfo) getEndpointURL() string {
 	return n.endpointURL
 }
 
+// isReadOnly returns whether the namespace is read-only
+func (n *namespaceInfo) isReadOnly() bool {
+	if n == nil {
+		return false
+	}
+	return n.readOnly
+}
+
 // getDeletionPolicy returns the namespace deletion policy for a given service
 func (n *namespaceInfo) getDeletionPolicy(service string) string {
 	if n == nil {
@@ -174,6 +184,12 @@ func (c *NamespaceCache) GetDeletionPolicy(namespace string, service string) (st
 	return "", false
 }
 
+// GetReadOnly returns true if the namespace is annotated as read-only
+func (c *NamespaceCache) GetReadOnly(namespace string) bool {
+	info, ok := c.getNamespaceInfo(namespace)
+	return ok && info.isReadOnly()
+}
+
 // getNamespaceInfo reads a namespace cached annotations and
 // return a given namespace default aws region, owner account id and endpoint url.
 // This function is thread safe.
@@ -201,6 +217,10 @@ func (c *NamespaceCache) setNamespaceInfoFromK8sObject(ns *corev1.Namespace) {
 	if ok {
 		nsInfo.endpointURL = EndpointURL
 	}
+	ReadOnly, ok := nsa[ackv1alpha1.AnnotationReadOnly]
+	if ok {
+		nsInfo.readOnly = strings.ToLower(ReadOnly) == "true"
+	}
 
 	nsInfo.deletionPolicies = map[string]string{}
 	nsDeletionPolicySuffix := "." + ackv1alpha1.AnnotationDeletionPolicy
@@ -64,6 +64,7 @@ func TestNamespaceCache(t *testing.T) {
 					ackv1alpha1.AnnotationDefaultRegion:  "us-west-2",
 					ackv1alpha1.AnnotationOwnerAccountID: "012345678912",
 					ackv1alpha1.AnnotationEndpointURL:    "https://amazon-service.region.amazonaws.com",
+					ackv1alpha1.AnnotationReadOnly:       "true",
 				},
 			},
 		},
@@ -85,6 +86,8 @@ func TestNamespaceCache(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "https://amazon-service.region.amazonaws.com", endpointURL)
 
+	require.True(t, namespaceCache.GetReadOnly("production"))
+
 	// Test update events
 	_, err = k8sClient.CoreV1().Namespaces().Update(
 		context.Background(),
@@ -116,6 +119,8 @@ func TestNamespaceCache(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, "https://amazon-other-service.region.amazonaws.com", endpointURL)
 
+	require.False(t, namespaceCache.GetReadOnly("production"))
+
 	// Test delete events
 	err = k8sClient.CoreV1().Namespaces().Delete(
 		context.Background(),
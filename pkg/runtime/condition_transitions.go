@@ -0,0 +1,286 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// ConditionTransition records a change of the status of one of the
+// conditions of a resource during a reconciliation.
+type ConditionTransition struct {
+	// Time is the time of the reconciliation that changed the status
+	Time metav1.Time `json:"time"`
+	// Type is the type of the condition
+	Type ackv1alpha1.ConditionType `json:"type"`
+	// From is the status of the condition before the reconciliation, empty
+	// if the resource did not have the condition
+	From corev1.ConditionStatus `json:"from,omitempty"`
+	// To is the status of the condition after the reconciliation, empty if
+	// the condition was removed from the resource
+	To corev1.ConditionStatus `json:"to,omitempty"`
+	// Reason is the reason of the condition after the reconciliation
+	Reason string `json:"reason,omitempty"`
+	// Message is the message of the condition after the reconciliation
+	Message string `json:"message,omitempty"`
+}
+
+// ConditionTransitions returns one ConditionTransition, stamped with the
+// supplied time, for each condition whose status differs between the prior
+// and current conditions of a resource. Transitions of the current
+// conditions come first, in their order, followed by the conditions that
+// were removed.
+func ConditionTransitions(
+	prior []*ackv1alpha1.Condition,
+	current []*ackv1alpha1.Condition,
+	now metav1.Time,
+) []ConditionTransition {
+	priorStatuses := map[ackv1alpha1.ConditionType]corev1.ConditionStatus{}
+	for _, c := range prior {
+		if c != nil {
+			priorStatuses[c.Type] = c.Status
+		}
+	}
+
+	transitions := []ConditionTransition{}
+	seen := map[ackv1alpha1.ConditionType]bool{}
+	for _, c := range current {
+		if c == nil {
+			continue
+		}
+		seen[c.Type] = true
+		if priorStatuses[c.Type] == c.Status {
+			continue
+		}
+		transition := ConditionTransition{
+			Time: now,
+			Type: c.Type,
+			From: priorStatuses[c.Type],
+			To:   c.Status,
+		}
+		if c.Reason != nil {
+			transition.Reason = *c.Reason
+		}
+		if c.Message != nil {
+			transition.Message = *c.Message
+		}
+		transitions = append(transitions, transition)
+	}
+	for _, c := range prior {
+		if c == nil || seen[c.Type] {
+			continue
+		}
+		seen[c.Type] = true
+		transitions = append(transitions, ConditionTransition{
+			Time: now,
+			Type: c.Type,
+			From: c.Status,
+		})
+	}
+	return transitions
+}
+
+// conditionTransitionLog keeps, for each resource, the most recent
+// transitions of the statuses of the resource's conditions.
+type conditionTransitionLog struct {
+	sync.Mutex
+	// size is the maximum number of transitions kept for each resource
+	size    int
+	entries map[k8stypes.NamespacedName][]ConditionTransition
+}
+
+// newConditionTransitionLog returns a new, empty conditionTransitionLog
+// keeping at most size transitions for each resource.
+func newConditionTransitionLog(size int) *conditionTransitionLog {
+	return &conditionTransitionLog{
+		size:    size,
+		entries: map[k8stypes.NamespacedName][]ConditionTransition{},
+	}
+}
+
+// seed sets the transitions of the resource, unless the log already has
+// transitions for it. It is used to restore the transitions persisted in the
+// resource's annotations after the controller restarted.
+func (l *conditionTransitionLog) seed(
+	nn k8stypes.NamespacedName,
+	transitions []ConditionTransition,
+) {
+	l.Lock()
+	defer l.Unlock()
+	if _, ok := l.entries[nn]; ok || len(transitions) == 0 {
+		return
+	}
+	l.entries[nn] = l.trim(transitions)
+}
+
+// append adds the supplied transitions to the log of the resource, dropping
+// the oldest transitions beyond the size of the log, and returns the
+// resulting transitions of the resource.
+func (l *conditionTransitionLog) append(
+	nn k8stypes.NamespacedName,
+	transitions []ConditionTransition,
+) []ConditionTransition {
+	l.Lock()
+	defer l.Unlock()
+	entries := l.trim(append(l.entries[nn], transitions...))
+	l.entries[nn] = entries
+	return append([]ConditionTransition{}, entries...)
+}
+
+// get returns a copy of the transitions of the resource, oldest first
+func (l *conditionTransitionLog) get(
+	nn k8stypes.NamespacedName,
+) []ConditionTransition {
+	l.Lock()
+	defer l.Unlock()
+	return append([]ConditionTransition{}, l.entries[nn]...)
+}
+
+// forget removes the transitions of the resource
+func (l *conditionTransitionLog) forget(nn k8stypes.NamespacedName) {
+	l.Lock()
+	defer l.Unlock()
+	delete(l.entries, nn)
+}
+
+// trim returns the last size transitions of the supplied transitions
+func (l *conditionTransitionLog) trim(
+	transitions []ConditionTransition,
+) []ConditionTransition {
+	if len(transitions) <= l.size {
+		return transitions
+	}
+	return append(
+		[]ConditionTransition{}, transitions[len(transitions)-l.size:]...,
+	)
+}
+
+// ConditionTransitions returns the most recent transitions of the statuses
+// of the conditions of the resource with the supplied namespace and name,
+// oldest first. It returns nil if the condition transition log is disabled.
+func (r *resourceReconciler) ConditionTransitions(
+	nn k8stypes.NamespacedName,
+) []ConditionTransition {
+	if r.transitions == nil {
+		return nil
+	}
+	return r.transitions.get(nn)
+}
+
+// snapshotConditions returns a copy of the conditions of the supplied
+// resource before its reconciliation, or nil if the condition transition log
+// is disabled.
+func (r *resourceReconciler) snapshotConditions(
+	res acktypes.AWSResource,
+) []*ackv1alpha1.Condition {
+	if r.transitions == nil {
+		return nil
+	}
+	conditions := []*ackv1alpha1.Condition{}
+	for _, c := range res.Conditions() {
+		if c != nil {
+			conditions = append(conditions, c.DeepCopy())
+		}
+	}
+	return conditions
+}
+
+// recordConditionTransitions appends the transitions of the conditions of
+// the resource made by its latest reconciliation to the condition transition
+// log and, if configured, persists the log in the resource's
+// services.k8s.aws/condition-transitions annotation.
+//
+// Failures to patch the annotation are logged and otherwise ignored.
+func (r *resourceReconciler) recordConditionTransitions(
+	ctx context.Context,
+	nn k8stypes.NamespacedName,
+	prior []*ackv1alpha1.Condition,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+) {
+	if r.transitions == nil || ackcompare.IsNil(latest) {
+		return
+	}
+	if r.cfg.PersistConditionTransitions {
+		r.transitions.seed(nn, getPersistedConditionTransitions(desired))
+	}
+	transitions := ConditionTransitions(
+		prior, latest.Conditions(), metav1.Now(),
+	)
+	if len(transitions) == 0 {
+		return
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	for _, t := range transitions {
+		rlog.Debug(
+			"condition status transitioned",
+			"condition", t.Type,
+			"from", t.From,
+			"to", t.To,
+			"reason", t.Reason,
+		)
+	}
+	entries := r.transitions.append(nn, transitions)
+	if !r.cfg.PersistConditionTransitions {
+		return
+	}
+
+	val, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	res := desired.DeepCopy()
+	orig := res.DeepCopy().RuntimeObject()
+	annotations := res.MetaObject().GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ackv1alpha1.AnnotationConditionTransitions] = string(val)
+	res.MetaObject().SetAnnotations(annotations)
+	if err = r.kc.Patch(ctx, res.RuntimeObject(), client.MergeFrom(orig)); err != nil {
+		rlog.Debug(
+			"failed to update condition transitions annotation",
+			"error", err,
+		)
+	}
+}
+
+// getPersistedConditionTransitions returns the condition transitions
+// persisted in the annotations of the supplied resource, or nil if the
+// resource has no (valid) persisted transitions.
+func getPersistedConditionTransitions(
+	res acktypes.AWSResource,
+) []ConditionTransition {
+	val, ok := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationConditionTransitions]
+	if !ok {
+		return nil
+	}
+	transitions := []ConditionTransition{}
+	if err := json.Unmarshal([]byte(val), &transitions); err != nil {
+		return nil
+	}
+	return transitions
+}
@@ -0,0 +1,58 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestConditionTransitions(t *testing.T) {
+	assert := assert.New(t)
+	now := metav1.Now()
+	reason := "Throttling"
+
+	prior := []*ackv1alpha1.Condition{
+		{Type: ackv1alpha1.ConditionTypeResourceSynced, Status: corev1.ConditionTrue},
+		{Type: ackv1alpha1.ConditionTypeTerminal, Status: corev1.ConditionTrue},
+		{Type: ackv1alpha1.ConditionTypeAdvisory, Status: corev1.ConditionTrue},
+	}
+	current := []*ackv1alpha1.Condition{
+		{Type: ackv1alpha1.ConditionTypeResourceSynced, Status: corev1.ConditionFalse, Reason: &reason},
+		{Type: ackv1alpha1.ConditionTypeAdvisory, Status: corev1.ConditionTrue},
+		{Type: ackv1alpha1.ConditionTypeRecoverable, Status: corev1.ConditionTrue},
+	}
+
+	transitions := ackrt.ConditionTransitions(prior, current, now)
+	assert.Len(transitions, 3)
+	assert.Equal(ackv1alpha1.ConditionTypeResourceSynced, transitions[0].Type)
+	assert.Equal(corev1.ConditionTrue, transitions[0].From)
+	assert.Equal(corev1.ConditionFalse, transitions[0].To)
+	assert.Equal(reason, transitions[0].Reason)
+	assert.Equal(now, transitions[0].Time)
+	assert.Equal(ackv1alpha1.ConditionTypeRecoverable, transitions[1].Type)
+	assert.Equal(corev1.ConditionStatus(""), transitions[1].From)
+	assert.Equal(corev1.ConditionTrue, transitions[1].To)
+	assert.Equal(ackv1alpha1.ConditionTypeTerminal, transitions[2].Type)
+	assert.Equal(corev1.ConditionTrue, transitions[2].From)
+	assert.Equal(corev1.ConditionStatus(""), transitions[2].To)
+
+	assert.Empty(ackrt.ConditionTransitions(current, current, now))
+}
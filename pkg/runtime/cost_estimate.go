@@ -0,0 +1,133 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"strconv"
+	"sync"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// costEstimate is the estimated monthly cost of the AWS resource of a
+// resource, in US dollars, and the AWS account owning it
+type costEstimate struct {
+	account string
+	cost    float64
+}
+
+// costTracker keeps the latest estimated monthly cost of the AWS resource of
+// each resource, so that the total cost of the AWS resources of each AWS
+// account can be exposed as a metric.
+type costTracker struct {
+	sync.Mutex
+	estimates map[k8stypes.NamespacedName]costEstimate
+}
+
+// newCostTracker returns an empty costTracker
+func newCostTracker() *costTracker {
+	return &costTracker{
+		estimates: map[k8stypes.NamespacedName]costEstimate{},
+	}
+}
+
+// set records the estimated cost of the supplied resource and returns the
+// total estimated cost of the AWS resources of the supplied account.
+func (t *costTracker) set(
+	nn k8stypes.NamespacedName,
+	account string,
+	cost float64,
+) float64 {
+	t.Lock()
+	defer t.Unlock()
+	t.estimates[nn] = costEstimate{account: account, cost: cost}
+	return t.total(account)
+}
+
+// forget removes the estimated cost of the supplied resource. It returns the
+// account owning the resource and the remaining total estimated cost of the
+// AWS resources of the account, and false if no cost was recorded for the
+// resource.
+func (t *costTracker) forget(nn k8stypes.NamespacedName) (string, float64, bool) {
+	t.Lock()
+	defer t.Unlock()
+	estimate, ok := t.estimates[nn]
+	if !ok {
+		return "", 0, false
+	}
+	delete(t.estimates, nn)
+	return estimate.account, t.total(estimate.account), true
+}
+
+// total returns the total estimated cost of the AWS resources of the supplied
+// account. The caller must hold the lock.
+func (t *costTracker) total(account string) float64 {
+	total := 0.0
+	for _, estimate := range t.estimates {
+		if estimate.account == account {
+			total += estimate.cost
+		}
+	}
+	return total
+}
+
+// estimateCost records the estimated monthly cost of the supplied latest
+// resource's AWS resource in its services.k8s.aws/estimated-monthly-cost
+// annotation and in the ack_estimated_monthly_cost_dollars metric, if the
+// supplied resource manager implements AWSResourceCostEstimator.
+//
+// The annotation is set on the supplied resource only, and is persisted with
+// the resource's metadata. Estimator failures are logged and otherwise
+// ignored, since estimates are purely informational.
+func (r *resourceReconciler) estimateCost(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	latest acktypes.AWSResource,
+) {
+	estimator, ok := rm.(acktypes.AWSResourceCostEstimator)
+	if !ok {
+		return
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	rlog.Enter("rm.EstimateMonthlyCost")
+	cost, err := estimator.EstimateMonthlyCost(ctx, latest)
+	rlog.Exit("rm.EstimateMonthlyCost", err)
+	if err != nil {
+		rlog.Debug("failed to estimate monthly cost", "error", err)
+		return
+	}
+	annotations := latest.MetaObject().GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ackv1alpha1.AnnotationEstimatedMonthlyCost] = strconv.FormatFloat(cost, 'f', 2, 64)
+	latest.MetaObject().SetAnnotations(annotations)
+
+	account, _ := r.resolveOwnerAccountID(latest)
+	total := r.costs.set(namespacedName(latest), string(account), cost)
+	r.metrics.RecordEstimatedMonthlyCost(r.rd.GroupKind().Kind, string(account), total)
+}
+
+// forgetCost removes the estimated monthly cost of the supplied deleted
+// resource from the ack_estimated_monthly_cost_dollars metric.
+func (r *resourceReconciler) forgetCost(nn k8stypes.NamespacedName) {
+	if account, total, ok := r.costs.forget(nn); ok {
+		r.metrics.RecordEstimatedMonthlyCost(r.rd.GroupKind().Kind, account, total)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// createSlotTracker limits the number of resources of a kind that are created
+// and managed by the controller to the budget configured with
+// --create-budgets.
+//
+// A slot is reserved before a resource is created, so that concurrent creates
+// cannot collectively exceed the budget. The slot is released if the create
+// fails, and confirmed if it succeeds. Confirmed slots are held until the
+// resource is no longer managed. Resources whose AWS resource is found when
+// they are reconciled confirm a slot as well, which rebuilds the slots held
+// by existing resources after the controller restarts.
+type createSlotTracker struct {
+	sync.Mutex
+	budget int
+	// slots maps the resources holding a slot to whether the slot is
+	// confirmed
+	slots map[k8stypes.NamespacedName]bool
+}
+
+// newCreateSlotTracker returns a createSlotTracker with the supplied budget
+func newCreateSlotTracker(budget int) *createSlotTracker {
+	return &createSlotTracker{
+		budget: budget,
+		slots:  map[k8stypes.NamespacedName]bool{},
+	}
+}
+
+// reserve reserves a slot for the supplied resource and returns true, unless
+// the budget is used up. A resource already holding a slot keeps it.
+func (t *createSlotTracker) reserve(nn k8stypes.NamespacedName) bool {
+	t.Lock()
+	defer t.Unlock()
+	if _, ok := t.slots[nn]; ok {
+		return true
+	}
+	if len(t.slots) >= t.budget {
+		return false
+	}
+	t.slots[nn] = false
+	return true
+}
+
+// confirm marks the slot of the supplied resource as confirmed, taking a slot
+// even if the budget is used up, since the resource exists.
+func (t *createSlotTracker) confirm(nn k8stypes.NamespacedName) {
+	t.Lock()
+	defer t.Unlock()
+	t.slots[nn] = true
+}
+
+// cancel releases the slot of the supplied resource unless it is confirmed
+func (t *createSlotTracker) cancel(nn k8stypes.NamespacedName) {
+	t.Lock()
+	defer t.Unlock()
+	if !t.slots[nn] {
+		delete(t.slots, nn)
+	}
+}
+
+// release releases the slot of the supplied resource
+func (t *createSlotTracker) release(nn k8stypes.NamespacedName) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.slots, nn)
+}
+
+// reserveCreateSlot reserves a slot from the create budget of the
+// reconciler's kind for the supplied resource about to be created. When the
+// budget is used up, the resource's ACK.ResourceSynced condition is set to
+// False and a RequeueNeededAfter error is returned so that the creation is
+// retried later.
+func (r *resourceReconciler) reserveCreateSlot(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) error {
+	if r.createSlots == nil || r.createSlots.reserve(namespacedName(res)) {
+		return nil
+	}
+	ackrtlog.FromContext(ctx).Info(
+		"deferring resource creation, create budget exhausted",
+		"budget", r.createSlots.budget,
+	)
+	ackcondition.SetSynced(
+		res, corev1.ConditionFalse,
+		&ackcondition.NotSyncedMessage,
+		&ackcondition.CreateBudgetExhaustedReason,
+	)
+	return requeue.NeededAfter(
+		ackerr.CreateBudgetExhausted, requeue.DefaultRequeueAfterDuration,
+	)
+}
+
+// settleCreateSlot confirms the slot reserved for the supplied resource if it
+// was created, and releases it if the supplied create error is not nil.
+func (r *resourceReconciler) settleCreateSlot(
+	res acktypes.AWSResource,
+	createErr error,
+) {
+	if r.createSlots == nil {
+		return
+	}
+	if createErr != nil {
+		r.createSlots.cancel(namespacedName(res))
+		return
+	}
+	r.createSlots.confirm(namespacedName(res))
+}
+
+// confirmCreateSlot records that the supplied resource, whose AWS resource
+// exists, holds a slot of the create budget.
+func (r *resourceReconciler) confirmCreateSlot(res acktypes.AWSResource) {
+	if r.createSlots != nil {
+		r.createSlots.confirm(namespacedName(res))
+	}
+}
+
+// releaseCreateSlot releases the slot of the create budget held by the
+// supplied resource, which is no longer managed.
+func (r *resourceReconciler) releaseCreateSlot(res acktypes.AWSResource) {
+	if r.createSlots != nil {
+		r.createSlots.release(namespacedName(res))
+	}
+}
+
+// namespacedName returns the namespace and name of the supplied resource
+func namespacedName(res acktypes.AWSResource) k8stypes.NamespacedName {
+	return k8stypes.NamespacedName{
+		Namespace: res.MetaObject().GetNamespace(),
+		Name:      res.MetaObject().GetName(),
+	}
+}
@@ -0,0 +1,274 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+const (
+	// trackedReconcileContextKey is the string key used to store the
+	// TrackedReconcile of a reconciliation in a Context
+	trackedReconcileContextKey = "ack.tracked-reconcile"
+	// recentReconcilesLimit is the number of completed reconciliations kept
+	// by the reconcile tracker
+	recentReconcilesLimit = 100
+	// debugServerShutdownTimeout is how long the debug server waits for
+	// in-flight requests when the controller stops
+	debugServerShutdownTimeout = 5 * time.Second
+)
+
+// Phases of a reconciliation reported by the debug server
+const (
+	reconcilePhaseResolveReferences = "resolve-references"
+	reconcilePhaseRead              = "read"
+	reconcilePhaseCreate            = "create"
+	reconcilePhaseUpdate            = "update"
+	reconcilePhaseLateInitialize    = "late-initialize"
+	reconcilePhaseDelete            = "delete"
+)
+
+// TrackedReconcile describes a reconciliation in flight or recently
+// completed, as reported by the debug server.
+type TrackedReconcile struct {
+	// Kind is the kind of the reconciled resource
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the reconciled resource
+	Namespace string `json:"namespace"`
+	// Name is the name of the reconciled resource
+	Name string `json:"name"`
+	// Phase is the phase the reconciliation is in, or was in when it ended
+	Phase string `json:"phase,omitempty"`
+	// Started is the time the reconciliation started
+	Started time.Time `json:"started"`
+	// Elapsed is how long the reconciliation has been running, or took
+	Elapsed string `json:"elapsed"`
+	// Outcome describes the result of a completed reconciliation
+	Outcome string `json:"outcome,omitempty"`
+}
+
+// trackedReconcile is a reconciliation followed by a reconcileTracker
+type trackedReconcile struct {
+	tracker *reconcileTracker
+	id      uint64
+	info    TrackedReconcile
+}
+
+// reconcileTracker keeps track of the reconciliations in flight and of the
+// most recently completed ones, across all the reconcilers of the service
+// controller.
+type reconcileTracker struct {
+	sync.Mutex
+	nextID   uint64
+	inflight map[uint64]*trackedReconcile
+	recent   []TrackedReconcile
+}
+
+// reconciles tracks the reconciliations of the service controller when the
+// debug server is enabled.
+var reconciles = &reconcileTracker{
+	inflight: map[uint64]*trackedReconcile{},
+}
+
+// start records the start of the reconciliation of the supplied resource
+func (t *reconcileTracker) start(
+	kind string,
+	nn k8stypes.NamespacedName,
+) *trackedReconcile {
+	t.Lock()
+	defer t.Unlock()
+	t.nextID++
+	tr := &trackedReconcile{
+		tracker: t,
+		id:      t.nextID,
+		info: TrackedReconcile{
+			Kind:      kind,
+			Namespace: nn.Namespace,
+			Name:      nn.Name,
+			Started:   time.Now(),
+		},
+	}
+	t.inflight[tr.id] = tr
+	return tr
+}
+
+// setPhase records the phase the reconciliation entered
+func (tr *trackedReconcile) setPhase(phase string) {
+	tr.tracker.Lock()
+	defer tr.tracker.Unlock()
+	tr.info.Phase = phase
+}
+
+// finish records the end of the reconciliation and its outcome
+func (tr *trackedReconcile) finish(result ctrlrt.Result, err error) {
+	t := tr.tracker
+	t.Lock()
+	defer t.Unlock()
+	delete(t.inflight, tr.id)
+	info := tr.info
+	info.Elapsed = time.Since(info.Started).String()
+	switch {
+	case err != nil:
+		info.Outcome = "error: " + err.Error()
+	case result.RequeueAfter > 0:
+		info.Outcome = "requeue after " + result.RequeueAfter.String()
+	case result.Requeue:
+		info.Outcome = "requeue"
+	default:
+		info.Outcome = "done"
+	}
+	t.recent = append(t.recent, info)
+	if len(t.recent) > recentReconcilesLimit {
+		t.recent = t.recent[len(t.recent)-recentReconcilesLimit:]
+	}
+}
+
+// snapshot returns the reconciliations in flight, oldest first, and the
+// recently completed reconciliations, most recent first.
+func (t *reconcileTracker) snapshot() ([]TrackedReconcile, []TrackedReconcile) {
+	t.Lock()
+	defer t.Unlock()
+	inflight := make([]TrackedReconcile, 0, len(t.inflight))
+	for _, tr := range t.inflight {
+		info := tr.info
+		info.Elapsed = time.Since(info.Started).String()
+		inflight = append(inflight, info)
+	}
+	sort.Slice(inflight, func(i, j int) bool {
+		return inflight[i].Started.Before(inflight[j].Started)
+	})
+	recent := make([]TrackedReconcile, 0, len(t.recent))
+	for i := len(t.recent) - 1; i >= 0; i-- {
+		recent = append(recent, t.recent[i])
+	}
+	return inflight, recent
+}
+
+// trackReconcile starts tracking the reconciliation of the supplied resource
+// when the debug server is enabled. It returns the Context to use for the
+// reconciliation and a function recording its outcome.
+func (r *resourceReconciler) trackReconcile(
+	ctx context.Context,
+	nn k8stypes.NamespacedName,
+) (context.Context, func(ctrlrt.Result, error)) {
+	if r.cfg.DebugServerAddr == "" {
+		return ctx, func(ctrlrt.Result, error) {}
+	}
+	tr := reconciles.start(r.rd.GroupKind().Kind, nn)
+	return context.WithValue(ctx, trackedReconcileContextKey, tr), tr.finish
+}
+
+// setReconcilePhase records the phase the reconciliation tracked in the
+// supplied Context entered. It is a no-op for untracked reconciliations.
+func setReconcilePhase(ctx context.Context, phase string) {
+	if tr, ok := ctx.Value(trackedReconcileContextKey).(*trackedReconcile); ok {
+		tr.setPhase(phase)
+	}
+}
+
+// conditionTransitionsProvider is implemented by the reconcilers keeping a
+// condition transition log
+type conditionTransitionsProvider interface {
+	ConditionTransitions(k8stypes.NamespacedName) []ConditionTransition
+}
+
+// newDebugHandler returns the handler of the debug server, serving:
+//
+//   - /debug/reconciles: the reconciliations in flight and the recently
+//     completed ones
+//   - /debug/condition-transitions?kind=&namespace=&name=: the condition
+//     transition log of a resource, when --condition-transition-log-size is
+//     set
+func newDebugHandler(
+	reconcilers func() []acktypes.AWSResourceReconciler,
+) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/reconciles", func(w http.ResponseWriter, req *http.Request) {
+		inflight, recent := reconciles.snapshot()
+		writeDebugJSON(w, map[string]interface{}{
+			"inflight": inflight,
+			"recent":   recent,
+		})
+	})
+	mux.HandleFunc("/debug/condition-transitions", func(w http.ResponseWriter, req *http.Request) {
+		query := req.URL.Query()
+		nn := k8stypes.NamespacedName{
+			Namespace: query.Get("namespace"),
+			Name:      query.Get("name"),
+		}
+		for _, rec := range reconcilers() {
+			gk := rec.GroupKind()
+			if gk == nil || gk.Kind != query.Get("kind") {
+				continue
+			}
+			if provider, ok := rec.(conditionTransitionsProvider); ok {
+				writeDebugJSON(w, provider.ConditionTransitions(nn))
+				return
+			}
+		}
+		http.Error(w, "unknown kind", http.StatusNotFound)
+	})
+	return mux
+}
+
+// writeDebugJSON writes the supplied value as indented JSON
+func writeDebugJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(v)
+}
+
+// runDebugServer serves the debug endpoints on the supplied address until the
+// supplied Context is done.
+func runDebugServer(
+	ctx context.Context,
+	log logr.Logger,
+	addr string,
+	reconcilers func() []acktypes.AWSResourceReconciler,
+) error {
+	server := &http.Server{
+		Addr:              addr,
+		Handler:           newDebugHandler(reconcilers),
+		ReadHeaderTimeout: debugServerShutdownTimeout,
+	}
+	errs := make(chan error, 1)
+	go func() {
+		log.Info("starting debug server", "address", addr)
+		errs <- server.ListenAndServe()
+	}()
+	select {
+	case err := <-errs:
+		if errors.Is(err, http.ErrServerClosed) {
+			return nil
+		}
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), debugServerShutdownTimeout)
+		defer cancel()
+		return server.Shutdown(shutdownCtx)
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// computeDerivedStatus runs the derived Status computations registered by the
+// supplied resource manager, when it implements
+// acktypes.DerivedStatusComputer, on the latest observed state of a resource.
+// The computed fields are persisted along with the rest of the Status when
+// the resource's Status is patched.
+//
+// Failing computations are logged and otherwise ignored, so that derived
+// Status fields never prevent a resource from being synchronized.
+func (r *resourceReconciler) computeDerivedStatus(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	latest acktypes.AWSResource,
+) {
+	computer, ok := rm.(acktypes.DerivedStatusComputer)
+	if !ok {
+		return
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	for i, compute := range computer.DerivedStatusComputations() {
+		if err := compute(ctx, latest); err != nil {
+			rlog.Info(
+				"WARNING: failed to compute derived status",
+				"computation", i,
+				"error", err.Error(),
+			)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// applyAWSErrorSeverity overrides the handling of the supplied reconciliation
+// error when it is, or wraps, an AWS error whose code is mapped to a severity
+// with the --aws-error-severity flag:
+//
+//   - terminal: the resource is placed in a Terminal condition and
+//     ackerr.Terminal is returned
+//   - recoverable: the AWS error itself is returned, so the reconciliation is
+//     retried with exponential backoff
+//   - requeue-after: the AWS error is wrapped in a RequeueNeededAfter error
+//     with the configured duration
+//
+// Errors whose code is not mapped are returned unchanged, along with the
+// supplied latest resource.
+func (r *resourceReconciler) applyAWSErrorSeverity(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+	err error,
+) (acktypes.AWSResource, error) {
+	if err == nil || len(r.errorSeverities) == 0 {
+		return latest, err
+	}
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return latest, err
+	}
+	severity, ok := r.errorSeverities[awsErr.Code()]
+	if !ok {
+		return latest, err
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	rlog.Debug(
+		"applying configured severity to AWS error",
+		"code", awsErr.Code(),
+		"severity", severity.Kind,
+	)
+	switch severity.Kind {
+	case ackcfg.AWSErrorSeverityTerminal:
+		res := latest
+		if ackcompare.IsNil(res) {
+			res = desired
+		}
+		msg := awsErr.Error()
+		ackcondition.SetTerminal(res, corev1.ConditionTrue, &msg, nil)
+		ackcondition.SetSynced(res, corev1.ConditionFalse, &ackcondition.NotSyncedMessage, &msg)
+		return res, ackerr.Terminal
+	case ackcfg.AWSErrorSeverityRecoverable:
+		return latest, awsErr
+	case ackcfg.AWSErrorSeverityRequeueAfter:
+		return latest, requeue.NeededAfter(awsErr, severity.RequeueAfter)
+	}
+	return latest, err
+}
@@ -0,0 +1,167 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+const (
+	// explainContextKey is the string key used to store the explanation of
+	// a reconciliation in a Context
+	explainContextKey = "ack.explain"
+	// explainStepAccount is the step resolving the AWS account of a resource
+	explainStepAccount = "account"
+	// explainStepRegion is the step resolving the AWS region of a resource
+	explainStepRegion = "region"
+	// explainStepRead is the step reading the AWS resource and deciding
+	// whether to create it
+	explainStepRead = "read"
+	// explainStepUpdate is the step deciding whether to update the AWS
+	// resource
+	explainStepUpdate = "update"
+	// explainStepRequeue is the step deciding when the resource is
+	// reconciled again
+	explainStepRequeue = "requeue"
+)
+
+// ExplainEntry records a decision made by the reconciler during the
+// reconciliation of a resource in explain mode.
+type ExplainEntry struct {
+	// Step is the phase of the reconciliation the decision was made in
+	Step string `json:"step"`
+	// Decision describes the decision and its rationale
+	Decision string `json:"decision"`
+}
+
+// explanation collects the decisions made during a reconciliation
+type explanation struct {
+	sync.Mutex
+	entries []ExplainEntry
+}
+
+// WithExplanation returns a copy of the supplied Context recording the
+// decisions passed to Explain.
+func WithExplanation(ctx context.Context) context.Context {
+	return context.WithValue(ctx, explainContextKey, &explanation{})
+}
+
+// Explain records a decision made during the reconciliation, if the supplied
+// Context was returned by WithExplanation. It is a no-op otherwise.
+func Explain(ctx context.Context, step string, format string, args ...interface{}) {
+	e, ok := ctx.Value(explainContextKey).(*explanation)
+	if !ok {
+		return
+	}
+	e.Lock()
+	defer e.Unlock()
+	e.entries = append(e.entries, ExplainEntry{
+		Step:     step,
+		Decision: fmt.Sprintf(format, args...),
+	})
+}
+
+// ExplanationFromContext returns the decisions recorded in the supplied
+// Context, in the order they were made, or nil if the Context was not
+// returned by WithExplanation.
+func ExplanationFromContext(ctx context.Context) []ExplainEntry {
+	e, ok := ctx.Value(explainContextKey).(*explanation)
+	if !ok {
+		return nil
+	}
+	e.Lock()
+	defer e.Unlock()
+	return append([]ExplainEntry{}, e.entries...)
+}
+
+// IsExplainEnabled returns true if the supplied AWSResource has the
+// services.k8s.aws/explain annotation set to "true", which asks the
+// reconciler to record the decisions it makes in the
+// services.k8s.aws/explanation annotation.
+func IsExplainEnabled(res acktypes.AWSResource) bool {
+	value := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationExplain]
+	return strings.ToLower(value) == "true"
+}
+
+// writeExplanation patches the services.k8s.aws/explanation annotation of
+// the supplied resource with the decisions recorded in the supplied Context.
+//
+// Failures to patch the annotation are logged and otherwise ignored.
+func (r *resourceReconciler) writeExplanation(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+) {
+	entries := ExplanationFromContext(ctx)
+	if entries == nil {
+		return
+	}
+	val, err := json.Marshal(entries)
+	if err != nil {
+		return
+	}
+	res := desired.DeepCopy()
+	orig := res.DeepCopy().RuntimeObject()
+	annotations := res.MetaObject().GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if annotations[ackv1alpha1.AnnotationExplanation] == string(val) {
+		return
+	}
+	annotations[ackv1alpha1.AnnotationExplanation] = string(val)
+	res.MetaObject().SetAnnotations(annotations)
+	if err = r.kc.Patch(ctx, res.RuntimeObject(), client.MergeFrom(orig)); err != nil {
+		ackrtlog.FromContext(ctx).Debug(
+			"failed to update explanation annotation",
+			"error", err,
+		)
+	}
+}
+
+// explainResult records when the resource is reconciled again, given the
+// result and error returned by HandleReconcileError.
+func explainResult(ctx context.Context, result ctrlrt.Result, err error) {
+	switch {
+	case err != nil:
+		Explain(ctx, explainStepRequeue, "requeueing with backoff after error: %v", err)
+	case result.RequeueAfter > 0:
+		Explain(ctx, explainStepRequeue, "requeueing after %s", result.RequeueAfter)
+	case result.Requeue:
+		Explain(ctx, explainStepRequeue, "requeueing immediately")
+	default:
+		Explain(ctx, explainStepRequeue, "not requeueing until the resource changes")
+	}
+}
+
+// differentPaths returns the comma-separated paths of the differences in the
+// supplied Delta.
+func differentPaths(delta *ackcompare.Delta) string {
+	paths := make([]string, 0, len(delta.Differences))
+	for _, diff := range delta.Differences {
+		paths = append(paths, diff.Path.String())
+	}
+	return strings.Join(paths, ", ")
+}
@@ -0,0 +1,43 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestExplain(t *testing.T) {
+	assert := assert.New(t)
+
+	// Decisions are not recorded unless explain mode is enabled
+	ctx := context.TODO()
+	ackrt.Explain(ctx, "read", "AWS resource not found, creating it")
+	assert.Nil(ackrt.ExplanationFromContext(ctx))
+
+	ctx = ackrt.WithExplanation(ctx)
+	assert.Empty(ackrt.ExplanationFromContext(ctx))
+	ackrt.Explain(ctx, "region", "using region %q from the %s", "us-west-2", "namespace annotation")
+	ackrt.Explain(ctx, "read", "AWS resource not found, creating it")
+
+	entries := ackrt.ExplanationFromContext(ctx)
+	assert.Equal([]ackrt.ExplainEntry{
+		{Step: "region", Decision: `using region "us-west-2" from the namespace annotation`},
+		{Step: "read", Decision: "AWS resource not found, creating it"},
+	}, entries)
+}
@@ -0,0 +1,77 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// isManaged returns true if the supplied resource carries either the
+// finalizer of the supplied resource descriptor or the supplied finalizer
+// override, so that resources managed before the override was configured
+// are still recognized as managed.
+func isManaged(
+	rd acktypes.AWSResourceDescriptor,
+	finalizer string,
+	res acktypes.AWSResource,
+) bool {
+	if rd.IsManaged(res) {
+		return true
+	}
+	return finalizer != "" && containsString(res.MetaObject().GetFinalizers(), finalizer)
+}
+
+// markManaged places the supplied finalizer override on the supplied
+// resource, or the finalizer of the resource descriptor if no override is
+// configured.
+func markManaged(
+	rd acktypes.AWSResourceDescriptor,
+	finalizer string,
+	res acktypes.AWSResource,
+) {
+	if finalizer == "" {
+		rd.MarkManaged(res)
+		return
+	}
+	metaObj := res.MetaObject()
+	if !containsString(metaObj.GetFinalizers(), finalizer) {
+		metaObj.SetFinalizers(append(metaObj.GetFinalizers(), finalizer))
+	}
+}
+
+// markUnmanaged removes both the supplied finalizer override and the
+// finalizer of the resource descriptor from the supplied resource.
+func markUnmanaged(
+	rd acktypes.AWSResourceDescriptor,
+	finalizer string,
+	res acktypes.AWSResource,
+) {
+	if finalizer != "" {
+		metaObj := res.MetaObject()
+		finalizers := []string{}
+		for _, f := range metaObj.GetFinalizers() {
+			if f != finalizer {
+				finalizers = append(finalizers, f)
+			}
+		}
+		metaObj.SetFinalizers(finalizers)
+	}
+	rd.MarkUnmanaged(res)
+}
+
+// isManaged returns true if the supplied resource is under the management of
+// the controller.
+func (r *resourceReconciler) isManaged(res acktypes.AWSResource) bool {
+	return isManaged(r.rd, r.cfg.FinalizerOverride, res)
+}
@@ -0,0 +1,105 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"sync"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// ForceReconcilePredicate admits the update events of the CRs whose
+// services.k8s.aws/force-reconcile annotation was set or changed to a
+// non-empty value. It is combined with predicate.GenerationChangedPredicate
+// so that this annotation, and no other, wakes the controller up without a
+// generation change.
+type ForceReconcilePredicate struct{}
+
+// Create implements predicate.Predicate
+func (ForceReconcilePredicate) Create(event.CreateEvent) bool { return false }
+
+// Delete implements predicate.Predicate
+func (ForceReconcilePredicate) Delete(event.DeleteEvent) bool { return false }
+
+// Generic implements predicate.Predicate
+func (ForceReconcilePredicate) Generic(event.GenericEvent) bool { return false }
+
+// Update implements predicate.Predicate
+func (ForceReconcilePredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		return false
+	}
+	value := e.ObjectNew.GetAnnotations()[ackv1alpha1.AnnotationForceReconcile]
+	return value != "" &&
+		value != e.ObjectOld.GetAnnotations()[ackv1alpha1.AnnotationForceReconcile]
+}
+
+// forcedReconcileTracker remembers, for each resource, the value of the
+// services.k8s.aws/force-reconcile annotation that was last handled, so that
+// the annotation can be left on the CR without forcing every later
+// reconciliation.
+type forcedReconcileTracker struct {
+	sync.Mutex
+	handled map[k8stypes.NamespacedName]string
+}
+
+// newForcedReconcileTracker returns a new, empty forcedReconcileTracker
+func newForcedReconcileTracker() *forcedReconcileTracker {
+	return &forcedReconcileTracker{
+		handled: map[k8stypes.NamespacedName]string{},
+	}
+}
+
+// requested returns true if the supplied resource carries a
+// services.k8s.aws/force-reconcile annotation whose value was not handled
+// yet.
+func (t *forcedReconcileTracker) requested(
+	nn k8stypes.NamespacedName,
+	res acktypes.AWSResource,
+) bool {
+	value := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationForceReconcile]
+	if value == "" {
+		return false
+	}
+	t.Lock()
+	defer t.Unlock()
+	return t.handled[nn] != value
+}
+
+// record records that the current value of the supplied resource's
+// services.k8s.aws/force-reconcile annotation was handled.
+func (t *forcedReconcileTracker) record(
+	nn k8stypes.NamespacedName,
+	res acktypes.AWSResource,
+) {
+	value := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationForceReconcile]
+	t.Lock()
+	defer t.Unlock()
+	if value == "" {
+		delete(t.handled, nn)
+		return
+	}
+	t.handled[nn] = value
+}
+
+// forget removes the value recorded for the resource
+func (t *forcedReconcileTracker) forget(nn k8stypes.NamespacedName) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.handled, nn)
+}
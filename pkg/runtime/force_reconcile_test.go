@@ -0,0 +1,59 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	k8sobj "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func objectWithAnnotations(annotations map[string]string) *k8sobj.Unstructured {
+	obj := &k8sobj.Unstructured{}
+	obj.SetAnnotations(annotations)
+	return obj
+}
+
+func TestForceReconcilePredicate(t *testing.T) {
+	assert := assert.New(t)
+	p := ackrt.ForceReconcilePredicate{}
+
+	none := objectWithAnnotations(nil)
+	first := objectWithAnnotations(map[string]string{
+		ackv1alpha1.AnnotationForceReconcile: "2023-01-01T00:00:00Z",
+	})
+	second := objectWithAnnotations(map[string]string{
+		ackv1alpha1.AnnotationForceReconcile: "2023-01-02T00:00:00Z",
+	})
+	other := objectWithAnnotations(map[string]string{
+		ackv1alpha1.AnnotationForceReconcile: "2023-01-02T00:00:00Z",
+		"example.com/unrelated":              "changed",
+	})
+
+	assert.True(p.Update(event.UpdateEvent{ObjectOld: none, ObjectNew: first}))
+	assert.True(p.Update(event.UpdateEvent{ObjectOld: first, ObjectNew: second}))
+	// unchanged, removed, or only other annotations changed
+	assert.False(p.Update(event.UpdateEvent{ObjectOld: first, ObjectNew: first}))
+	assert.False(p.Update(event.UpdateEvent{ObjectOld: first, ObjectNew: none}))
+	assert.False(p.Update(event.UpdateEvent{ObjectOld: second, ObjectNew: other}))
+
+	assert.False(p.Create(event.CreateEvent{Object: first}))
+	assert.False(p.Delete(event.DeleteEvent{Object: first}))
+	assert.False(p.Generic(event.GenericEvent{Object: first}))
+}
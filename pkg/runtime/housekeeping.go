@@ -0,0 +1,163 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// NormalizeConditionTimes sets, in place, the transition times of the
+// supplied current conditions whose type and status did not change since the
+// supplied prior conditions back to their prior transition time. Missing
+// transition times and transition times in the future are set to the supplied
+// time.
+func NormalizeConditionTimes(
+	prior []*ackv1alpha1.Condition,
+	current []*ackv1alpha1.Condition,
+	now metav1.Time,
+) {
+	priorByType := make(map[ackv1alpha1.ConditionType]*ackv1alpha1.Condition, len(prior))
+	for _, c := range prior {
+		if c != nil {
+			priorByType[c.Type] = c
+		}
+	}
+	for _, c := range current {
+		if c == nil {
+			continue
+		}
+		if p, ok := priorByType[c.Type]; ok && p.Status == c.Status &&
+			p.LastTransitionTime != nil && !p.LastTransitionTime.After(now.Time) {
+			t := *p.LastTransitionTime
+			c.LastTransitionTime = &t
+			continue
+		}
+		if c.LastTransitionTime == nil || c.LastTransitionTime.After(now.Time) {
+			t := now
+			c.LastTransitionTime = &t
+		}
+	}
+}
+
+// housekeepingEnabled returns true if the metadata of the supplied resource
+// must be tidied up by its reconciliation, that is if the
+// --enable-metadata-housekeeping flag is set and the resource is being
+// resynced after being synced.
+func (r *resourceReconciler) housekeepingEnabled(res acktypes.AWSResource) bool {
+	return r.cfg.EnableMetadataHousekeeping && !res.IsBeingDeleted() && IsSynced(res)
+}
+
+// staleAnnotations returns the sorted keys of the ACK bookkeeping annotations
+// of the supplied synced resource that are no longer relevant, either because
+// the feature maintaining them was disabled or because they describe a past
+// state of the resource.
+func (r *resourceReconciler) staleAnnotations(res acktypes.AWSResource) []string {
+	annotations := res.MetaObject().GetAnnotations()
+	stale := []string{}
+	isStale := map[string]bool{
+		ackv1alpha1.AnnotationReconcileCount:       !r.cfg.EnableReconcileCountAnnotations,
+		ackv1alpha1.AnnotationReconcileErrorCount:  !r.cfg.EnableReconcileCountAnnotations,
+		ackv1alpha1.AnnotationBackoffState:         !r.cfg.EnableBackoffPersistence,
+		ackv1alpha1.AnnotationConditionTransitions: !r.cfg.PersistConditionTransitions,
+		ackv1alpha1.AnnotationAdoptionObserved:     !r.cfg.AdoptionObserveFirst,
+		ackv1alpha1.AnnotationExplanation:          !IsExplainEnabled(res),
+		// The resource is not being deleted, so the recorded soft delete
+		// time was copied from another resource.
+		ackv1alpha1.AnnotationSoftDeleteAfter: true,
+	}
+	for key, ok := range isStale {
+		if _, found := annotations[key]; found && ok {
+			stale = append(stale, key)
+		}
+	}
+	// Updates scheduled in the past were applied already.
+	if applyAfter, err := GetApplyAfter(res); err == nil && applyAfter != nil &&
+		applyAfter.Before(time.Now()) {
+		stale = append(stale, ackv1alpha1.AnnotationApplyAfter)
+	}
+	sort.Strings(stale)
+	return stale
+}
+
+// pruneStaleAnnotations removes the stale ACK bookkeeping annotations of the
+// supplied resource, if housekeeping is enabled for it.
+//
+// Failures to patch the resource are logged and otherwise ignored, since the
+// annotations are pruned again on the next resync.
+func (r *resourceReconciler) pruneStaleAnnotations(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) {
+	if !r.housekeepingEnabled(res) {
+		return
+	}
+	stale := r.staleAnnotations(res)
+	if len(stale) == 0 {
+		return
+	}
+	orig := res.DeepCopy().RuntimeObject()
+	annotations := res.MetaObject().GetAnnotations()
+	for _, key := range stale {
+		delete(annotations, key)
+	}
+	res.MetaObject().SetAnnotations(annotations)
+	rlog := ackrtlog.FromContext(ctx)
+	if err := r.kc.Patch(ctx, res.RuntimeObject(), client.MergeFrom(orig)); err != nil {
+		rlog.Debug("failed to prune stale annotations", "error", err)
+		return
+	}
+	rlog.Debug("pruned stale annotations", "annotations", stale)
+}
+
+// snapshotHousekeepingConditions returns a copy of the conditions of the
+// supplied resource before its reconciliation, or nil if housekeeping is not
+// enabled for the resource.
+func (r *resourceReconciler) snapshotHousekeepingConditions(
+	res acktypes.AWSResource,
+) []*ackv1alpha1.Condition {
+	if !r.housekeepingEnabled(res) {
+		return nil
+	}
+	conditions := []*ackv1alpha1.Condition{}
+	for _, c := range res.Conditions() {
+		if c != nil {
+			conditions = append(conditions, c.DeepCopy())
+		}
+	}
+	return conditions
+}
+
+// normalizeConditionTimes keeps the transition times of the conditions of the
+// supplied latest resource whose status was not changed by its
+// reconciliation, so that they reflect actual transitions rather than the
+// time of the latest resync.
+func (r *resourceReconciler) normalizeConditionTimes(
+	prior []*ackv1alpha1.Condition,
+	latest acktypes.AWSResource,
+) {
+	if prior == nil || ackcompare.IsNil(latest) {
+		return
+	}
+	NormalizeConditionTimes(prior, latest.Conditions(), metav1.Now())
+}
@@ -0,0 +1,53 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestNormalizeConditionTimes(t *testing.T) {
+	require := require.New(t)
+	now := metav1.NewTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC))
+	synced := metav1.NewTime(now.Add(-72 * time.Hour))
+	future := metav1.NewTime(now.Add(time.Hour))
+
+	prior := []*ackv1alpha1.Condition{
+		{Type: ackv1alpha1.ConditionTypeResourceSynced, Status: corev1.ConditionTrue, LastTransitionTime: &synced},
+		{Type: ackv1alpha1.ConditionTypeAdvisory, Status: corev1.ConditionFalse, LastTransitionTime: &synced},
+	}
+	current := []*ackv1alpha1.Condition{
+		{Type: ackv1alpha1.ConditionTypeResourceSynced, Status: corev1.ConditionTrue, LastTransitionTime: &now},
+		{Type: ackv1alpha1.ConditionTypeAdvisory, Status: corev1.ConditionTrue, LastTransitionTime: &now},
+		{Type: ackv1alpha1.ConditionTypeTerminal, Status: corev1.ConditionFalse, LastTransitionTime: &future},
+		{Type: ackv1alpha1.ConditionTypeLateInitialized, Status: corev1.ConditionTrue},
+	}
+	ackrt.NormalizeConditionTimes(prior, current, now)
+
+	// Unchanged conditions keep their transition time
+	require.Equal(synced, *current[0].LastTransitionTime)
+	// Transitioned conditions keep the time of the transition
+	require.Equal(now, *current[1].LastTransitionTime)
+	// Missing and future transition times are set to the current time
+	require.Equal(now, *current[2].LastTransitionTime)
+	require.Equal(now, *current[3].LastTransitionTime)
+}
@@ -0,0 +1,204 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"unicode"
+
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	rtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackconfig "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+const (
+	// maxTagKeyLength is the maximum length of AWS tag keys
+	maxTagKeyLength = 128
+	// maxTagValueLength is the maximum length of AWS tag values
+	maxTagValueLength = 256
+)
+
+// SanitizeTag returns the supplied tag key or value with the characters not
+// allowed in AWS tags replaced by '_', truncated to the supplied maximum
+// length. AWS tags allow letters, digits, whitespace and the characters
+// "_.:/=+-@".
+func SanitizeTag(s string, maxLength int) string {
+	sanitized := []rune{}
+	for _, c := range s {
+		if len(sanitized) == maxLength {
+			break
+		}
+		if !unicode.IsLetter(c) && !unicode.IsDigit(c) && !unicode.IsSpace(c) &&
+			!strings.ContainsRune("_.:/=+-@", c) {
+			c = '_'
+		}
+		sanitized = append(sanitized, c)
+	}
+	return string(sanitized)
+}
+
+// GetLabelTags returns the tags propagated from the labels of the supplied
+// resource whose keys are configured with --label-tag-keys.
+func GetLabelTags(
+	config *ackconfig.Config,
+	obj rtclient.Object,
+) acktags.Tags {
+	tags := acktags.NewTags()
+	if obj == nil || config == nil || len(config.LabelTagKeys) == 0 {
+		return tags
+	}
+	labels := obj.GetLabels()
+	for _, key := range config.LabelTagKeys {
+		if value, ok := labels[key]; ok {
+			tags[SanitizeTag(key, maxTagKeyLength)] = SanitizeTag(value, maxTagValueLength)
+		}
+	}
+	return tags
+}
+
+// SetSpecTags sets, in place, the supplied tags and removes the tags with the
+// supplied keys in the Spec of the supplied object. It supports the tags
+// fields of ACK resources, which are either a map of tag values keyed by tag
+// key or a list of objects with "key" and "value" fields.
+//
+// It returns false, without modifying the object, if the object has no tags
+// field, since its type is then unknown.
+func SetSpecTags(
+	obj rtclient.Object,
+	set acktags.Tags,
+	remove []string,
+) (bool, error) {
+	content, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return false, err
+	}
+	spec, ok := content["spec"].(map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+	switch tags := spec["tags"].(type) {
+	case map[string]interface{}:
+		for _, key := range remove {
+			delete(tags, key)
+		}
+		for key, value := range set {
+			tags[key] = value
+		}
+	case []interface{}:
+		kept := []interface{}{}
+		pending := acktags.NewTags()
+		for key, value := range set {
+			pending[key] = value
+		}
+		for _, tag := range tags {
+			fields, ok := tag.(map[string]interface{})
+			if !ok {
+				kept = append(kept, tag)
+				continue
+			}
+			key, _ := fields["key"].(string)
+			if value, ok := pending[key]; ok {
+				fields["value"] = value
+				delete(pending, key)
+			} else if containsString(remove, key) {
+				continue
+			}
+			kept = append(kept, fields)
+		}
+		keys := make([]string, 0, len(pending))
+		for key := range pending {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			kept = append(kept, map[string]interface{}{"key": key, "value": pending[key]})
+		}
+		spec["tags"] = kept
+	default:
+		return false, nil
+	}
+	return true, k8sruntime.DefaultUnstructuredConverter.FromUnstructured(content, obj)
+}
+
+// containsString returns true if the supplied slice contains the supplied
+// string
+func containsString(values []string, s string) bool {
+	for _, value := range values {
+		if value == s {
+			return true
+		}
+	}
+	return false
+}
+
+// syncLabelTags keeps the tags propagated from the labels of the supplied
+// desired resource, configured with --label-tag-keys, in sync with the
+// labels. The values of the propagated tags are set in its Spec, overriding
+// any value persisted earlier, and the tags whose labels were removed are
+// removed from its Spec, so that the following EnsureTags and update apply
+// the changes to the AWS resource.
+//
+// The keys of the propagated tags are recorded in the resource's
+// services.k8s.aws/label-tags annotation, and the resource's metadata and
+// Spec are patched when they change.
+func (r *resourceReconciler) syncLabelTags(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+) error {
+	if len(r.cfg.LabelTagKeys) == 0 || r.isReadOnly(desired) {
+		return nil
+	}
+	labelTags := GetLabelTags(&r.cfg, desired.RuntimeObject())
+	keys := make([]string, 0, len(labelTags))
+	for key := range labelTags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	annotations := desired.MetaObject().GetAnnotations()
+	stale := []string{}
+	if recorded := annotations[ackv1alpha1.AnnotationLabelTags]; recorded != "" {
+		for _, key := range strings.Split(recorded, ",") {
+			if _, ok := labelTags[key]; !ok {
+				stale = append(stale, key)
+			}
+		}
+	}
+
+	orig := desired.DeepCopy()
+	if _, err := SetSpecTags(desired.RuntimeObject(), labelTags, stale); err != nil {
+		return err
+	}
+	annotations = desired.MetaObject().GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if len(keys) > 0 {
+		annotations[ackv1alpha1.AnnotationLabelTags] = strings.Join(keys, ",")
+	} else {
+		delete(annotations, ackv1alpha1.AnnotationLabelTags)
+	}
+	desired.MetaObject().SetAnnotations(annotations)
+	if len(stale) > 0 {
+		ackrtlog.FromContext(ctx).Info("removing tags of removed labels", "tags", stale)
+	}
+	return r.patchResourceMetadataAndSpec(ctx, orig, desired)
+}
@@ -0,0 +1,67 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// handleMissingSecret places a resource whose reconciliation failed because
+// a Secret or Secret key it refers to does not exist in an
+// ACK.ReferencesResolved=False condition, and requeues it after
+// --missing-secret-requeue-seconds rather than retrying it with the usual
+// backoff, since the Secret is typically created shortly after the resource,
+// e.g. by a GitOps tool.
+//
+// Other errors, and all errors when --on-missing-secret is "fail", are
+// returned unchanged, along with the supplied latest resource.
+func (r *resourceReconciler) handleMissingSecret(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+	err error,
+) (acktypes.AWSResource, error) {
+	if r.cfg.OnMissingSecret == ackcfg.OnMissingSecretFail || !errors.Is(err, ackerr.SecretNotFound) {
+		return latest, err
+	}
+	res := latest
+	if ackcompare.IsNil(res) {
+		res = desired
+	}
+	after := time.Duration(r.cfg.MissingSecretRequeueSeconds) * time.Second
+	if after == 0 {
+		after = requeue.DefaultRequeueAfterDuration
+	}
+	ackrtlog.FromContext(ctx).Info("waiting for referenced Secret to exist", "after", after)
+	reason := err.Error()
+	ackcondition.SetReferencesResolved(
+		res, corev1.ConditionFalse, &ackcondition.MissingSecretMessage, &reason,
+	)
+	ackcondition.SetSynced(
+		res, corev1.ConditionFalse, &ackcondition.NotSyncedMessage, &ackcondition.MissingSecretMessage,
+	)
+	return res, requeue.NeededAfter(err, after)
+}
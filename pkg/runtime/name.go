@@ -0,0 +1,121 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"strings"
+
+	rtclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktags "github.com/aws-controllers-k8s/runtime/pkg/tags"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+const (
+	// ResourceUIDNameFormat is replaced by the Kubernetes UID of the CR when
+	// computing a resource name.
+	ResourceUIDNameFormat = "%K8S_RESOURCE_UID%"
+	// ShortResourceUIDNameFormat is replaced by the first eight characters of
+	// the Kubernetes UID of the CR when computing a resource name.
+	ShortResourceUIDNameFormat = "%K8S_RESOURCE_SHORT_UID%"
+	// shortUIDLength is the number of UID characters used by
+	// ShortResourceUIDNameFormat
+	shortUIDLength = 8
+)
+
+// nameConflictErrorCodeSuffixes contains the suffixes of the AWS error codes
+// that AWS service APIs return when a resource cannot be created because a
+// resource with the same name already exists, e.g. "EntityAlreadyExists" or
+// "DBInstanceAlreadyExists"
+var nameConflictErrorCodeSuffixes = []string{
+	"AlreadyExists",
+	"AlreadyExistsException",
+	"AlreadyExistsFault",
+}
+
+// ComputeResourceName returns the name for the supplied Kubernetes object
+// after expanding all the name formats in the supplied template.
+//
+// Supported name formats are %K8S_NAMESPACE%, %K8S_RESOURCE_NAME%,
+// %K8S_RESOURCE_UID% and %K8S_RESOURCE_SHORT_UID%. Because all of these are
+// stable for the lifetime of the CR, the returned name is deterministic.
+func ComputeResourceName(
+	template string,
+	obj rtclient.Object,
+) string {
+	uid := string(obj.GetUID())
+	shortUID := uid
+	if len(shortUID) > shortUIDLength {
+		shortUID = shortUID[:shortUIDLength]
+	}
+	return strings.NewReplacer(
+		acktags.NamespaceTagFormat, obj.GetNamespace(),
+		acktags.ResourceNameTagFormat, obj.GetName(),
+		ShortResourceUIDNameFormat, shortUID,
+		ResourceUIDNameFormat, uid,
+	).Replace(template)
+}
+
+// isNameConflict returns true if the supplied error returned from a Create
+// call indicates that a resource with the same name already exists in the
+// backend AWS service API.
+func isNameConflict(err error) bool {
+	awsErr, ok := ackerr.AWSError(err)
+	if !ok {
+		return false
+	}
+	for _, suffix := range nameConflictErrorCodeSuffixes {
+		if strings.HasSuffix(awsErr.Code(), suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// ensureResourceName computes and persists a name for resources implementing
+// AWSResourceWithSpecName that have an empty Spec name. It is a no-op for all
+// other resources.
+func (r *resourceReconciler) ensureResourceName(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) error {
+	named, ok := res.(acktypes.AWSResourceWithSpecName)
+	if !ok || named.SpecName() != "" {
+		return nil
+	}
+
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("r.ensureResourceName")
+	defer func() {
+		exit(err)
+	}()
+
+	template := r.cfg.ResourceNameTemplate
+	if template == "" {
+		template = ackcfg.DefaultResourceNameTemplate
+	}
+	orig := res.DeepCopy()
+	name := ComputeResourceName(template, res.RuntimeObject())
+	named.SetSpecName(name)
+	if err = r.patchResourceMetadataAndSpec(ctx, orig, res); err != nil {
+		return err
+	}
+	rlog.Info("computed resource name", "computed_name", name)
+	return nil
+}
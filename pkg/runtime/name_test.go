@@ -0,0 +1,47 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8sobj "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestComputeResourceName(t *testing.T) {
+	require := require.New(t)
+
+	obj := &k8sobj.Unstructured{}
+	obj.SetNamespace("production")
+	obj.SetName("mybook")
+	obj.SetUID(k8stypes.UID("3f0e6a84-5a1c-4e0b-9b3e-0d1e2f3a4b5c"))
+
+	require.Equal(
+		"production-mybook-3f0e6a84",
+		ackrt.ComputeResourceName(ackcfg.DefaultResourceNameTemplate, obj),
+	)
+	require.Equal(
+		"mybook-3f0e6a84-5a1c-4e0b-9b3e-0d1e2f3a4b5c",
+		ackrt.ComputeResourceName("%K8S_RESOURCE_NAME%-%K8S_RESOURCE_UID%", obj),
+	)
+	require.Equal(
+		"static-name",
+		ackrt.ComputeResourceName("static-name", obj),
+	)
+}
@@ -0,0 +1,118 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// orphanedResourceKey returns the key under which an orphaned resource is
+// recorded in the orphaned resources ConfigMap, e.g.
+// "Bucket.s3.services.k8s.aws.default.my-bucket"
+func orphanedResourceKey(
+	gk *metav1.GroupKind,
+	res acktypes.AWSResource,
+) string {
+	return fmt.Sprintf(
+		"%s.%s.%s",
+		gk.String(),
+		res.MetaObject().GetNamespace(),
+		res.MetaObject().GetName(),
+	)
+}
+
+// handleOutOfBandFinalizerRemoval is called when a resource is being deleted
+// and its backend AWS resource still exists, but the ACK finalizer has already
+// been removed from the resource by something other than ACK. Once the
+// finalizer is gone, the Kubernetes API server may remove the resource before
+// ACK deletes the AWS resource, orphaning the AWS resource.
+//
+// The removal is logged and counted in the ack_orphaned_resources_total
+// metric. When the --orphaned-resources-configmap flag is set, the ARN of the
+// AWS resource is also recorded in that ConfigMap so it can be cleaned up
+// later on.
+func (r *resourceReconciler) handleOutOfBandFinalizerRemoval(
+	ctx context.Context,
+	observed acktypes.AWSResource,
+) {
+	rlog := ackrtlog.FromContext(ctx)
+	var arn string
+	if observed.Identifiers().ARN() != nil {
+		arn = string(*observed.Identifiers().ARN())
+	}
+	rlog.Info(
+		"WARNING: ACK finalizer was removed before the AWS resource was "+
+			"deleted. The AWS resource is orphaned if the resource is "+
+			"removed before its deletion completes",
+		"arn", arn,
+	)
+	if r.metrics != nil {
+		r.metrics.RecordOrphanedResource(r.rd.GroupKind().Kind)
+	}
+	if r.cfg.OrphanedResourcesConfigMap == "" || arn == "" {
+		return
+	}
+	if err := r.recordOrphanedResource(ctx, observed, arn); err != nil {
+		rlog.Info(
+			"failed to record orphaned resource",
+			"configmap", r.cfg.OrphanedResourcesConfigMap,
+			"error", err.Error(),
+		)
+	}
+}
+
+// recordOrphanedResource adds the supplied ARN to the ConfigMap configured
+// with the --orphaned-resources-configmap flag, creating the ConfigMap if it
+// does not exist.
+func (r *resourceReconciler) recordOrphanedResource(
+	ctx context.Context,
+	res acktypes.AWSResource,
+	arn string,
+) error {
+	namespace, name, err := r.cfg.ParseOrphanedResourcesConfigMap()
+	if err != nil {
+		return err
+	}
+	key := orphanedResourceKey(r.rd.GroupKind(), res)
+
+	var cm corev1.ConfigMap
+	err = r.apiReader.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &cm)
+	if apierrors.IsNotFound(err) {
+		cm = corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Data: map[string]string{key: arn},
+		}
+		return r.kc.Create(ctx, &cm)
+	}
+	if err != nil {
+		return err
+	}
+	if cm.Data == nil {
+		cm.Data = map[string]string{}
+	}
+	cm.Data[key] = arn
+	return r.kc.Update(ctx, &cm)
+}
@@ -0,0 +1,65 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"runtime/debug"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// reconcileRecovering calls reconcile and recovers from any panic raised
+// while reconciling the supplied resource, e.g. by a buggy resource manager,
+// so that a single resource cannot crash the controller.
+//
+// A recovered panic is logged with its stack trace, counted in the
+// ack_panic_recovered_total metric and reported in an ACK.Recoverable
+// condition, and the resource is requeued after the default requeue
+// interval.
+func (r *resourceReconciler) reconcileRecovering(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	res acktypes.AWSResource,
+) (latest acktypes.AWSResource, err error) {
+	defer func() {
+		value := recover()
+		if value == nil {
+			return
+		}
+		panicErr := ackerr.PanicRecoveredFor(value)
+		ackrtlog.FromContext(ctx).Info(
+			"WARNING: recovered from panic while reconciling resource",
+			"error", panicErr.Error(),
+			"stack", string(debug.Stack()),
+		)
+		if r.metrics != nil {
+			r.metrics.RecordPanicRecovered(r.rd.GroupKind().Kind)
+		}
+		reason := panicErr.Error()
+		ackcondition.SetRecoverable(
+			res, corev1.ConditionTrue,
+			&ackcondition.PanicRecoveredMessage, &reason,
+		)
+		latest = res
+		err = requeue.NeededAfter(panicErr, requeue.DefaultRequeueAfterDuration)
+	}()
+	return r.reconcile(ctx, rm, res)
+}
@@ -0,0 +1,81 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+)
+
+const (
+	// patchConflictRetries is the number of times a patch of a resource
+	// failing with a conflict is retried before the conflict is returned.
+	patchConflictRetries = 3
+	// patchConflictBackoff is the time waited before retrying a patch failing
+	// with a conflict, multiplied by the number of the retry.
+	patchConflictBackoff = 100 * time.Millisecond
+)
+
+// patchWithConflictRetry calls the supplied patch function with a merge patch
+// from the supplied original object to the supplied modified object.
+//
+// When the patch fails with a conflict, because the resource was modified
+// since the original object was read, the resource is read again from the
+// Kubernetes API server and the merge patch is computed again against its
+// current resource version, so that the same changes are applied on top of
+// the modifications made by others. The patch is retried up to
+// patchConflictRetries times with a linear backoff. Conflicts are not
+// retried by reconcilers without an API reader, e.g. in unit tests.
+//
+// The merge patch last applied is returned along with any error.
+func (r *resourceReconciler) patchWithConflictRetry(
+	ctx context.Context,
+	original client.Object,
+	modified client.Object,
+	patchFn func(client.Patch) error,
+) (client.Patch, error) {
+	patch := client.MergeFrom(original)
+	err := patchFn(patch)
+	if r.apiReader == nil {
+		return patch, err
+	}
+	for retry := 1; retry <= patchConflictRetries && apierrors.IsConflict(err); retry++ {
+		current := modified.DeepCopyObject().(client.Object)
+		if getErr := r.apiReader.Get(
+			ctx, client.ObjectKeyFromObject(modified), current,
+		); getErr != nil {
+			return patch, err
+		}
+		ackrtlog.FromContext(ctx).Debug(
+			"conflict patching resource, retrying",
+			"retry", retry,
+			"resourceVersion", current.GetResourceVersion(),
+		)
+		select {
+		case <-ctx.Done():
+			return patch, err
+		case <-time.After(time.Duration(retry) * patchConflictBackoff):
+		}
+		original.SetResourceVersion(current.GetResourceVersion())
+		modified.SetResourceVersion(current.GetResourceVersion())
+		patch = client.MergeFrom(original)
+		err = patchFn(patch)
+	}
+	return patch, err
+}
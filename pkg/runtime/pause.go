@@ -0,0 +1,64 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// IsPaused returns true if the supplied AWSResource has the
+// services.k8s.aws/pause-reconciliation annotation set to "true", which
+// suspends its reconciliation.
+func IsPaused(res acktypes.AWSResource) bool {
+	value := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationPauseReconciliation]
+	return strings.ToLower(value) == "true"
+}
+
+// handlePaused sets an ACK.Paused condition on a resource whose
+// reconciliation is paused, without touching its AWS resource, and does not
+// requeue it. The conditions of the resource are reset by the first
+// reconciliation after the annotation is removed.
+func (r *resourceReconciler) handlePaused(
+	ctx context.Context,
+	req ctrlrt.Request,
+	desired acktypes.AWSResource,
+) (ctrlrt.Result, error) {
+	r.log.V(1).Info(
+		"skipping reconciliation of paused resource",
+		"kind", r.rd.GroupKind().Kind,
+		"namespace", req.Namespace,
+		"name", req.Name,
+	)
+	if c := ackcondition.Paused(desired); c != nil && c.Status == corev1.ConditionTrue {
+		return ctrlrt.Result{}, nil
+	}
+	latest := desired.DeepCopy()
+	ackcondition.SetPaused(
+		latest, corev1.ConditionTrue,
+		&ackcondition.PausedMessage,
+		&ackcondition.PausedReason,
+	)
+	if err := r.patchResourceStatus(ctx, desired, latest); err != nil {
+		return ctrlrt.Result{}, err
+	}
+	return ctrlrt.Result{}, nil
+}
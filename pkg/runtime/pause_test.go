@@ -0,0 +1,40 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestIsPaused(t *testing.T) {
+	assert := assert.New(t)
+
+	res, _, metaObj := resourceMocks()
+	assert.False(ackrt.IsPaused(res))
+
+	metaObj.SetAnnotations(map[string]string{
+		ackv1alpha1.AnnotationPauseReconciliation: "True",
+	})
+	assert.True(ackrt.IsPaused(res))
+
+	metaObj.SetAnnotations(map[string]string{
+		ackv1alpha1.AnnotationPauseReconciliation: "false",
+	})
+	assert.False(ackrt.IsPaused(res))
+}
@@ -0,0 +1,74 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// handleQuotaExceeded places a resource whose reconciliation failed because
+// a service quota or limit of the AWS account was reached in an
+// ACK.QuotaExceeded condition carrying the AWS error message, which usually
+// names the exceeded limit, and requeues it after
+// --quota-exceeded-requeue-seconds rather than retrying it with the usual
+// backoff.
+//
+// Other errors, and all errors when --quota-exceeded-requeue-seconds is 0,
+// are returned unchanged, along with the supplied latest resource.
+func (r *resourceReconciler) handleQuotaExceeded(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+	err error,
+) (acktypes.AWSResource, error) {
+	if r.cfg.QuotaExceededRequeueSeconds == 0 {
+		return latest, err
+	}
+	awsErr, ok := ackerr.QuotaExceeded(err)
+	if !ok {
+		return latest, err
+	}
+	res := latest
+	if ackcompare.IsNil(res) {
+		res = desired
+	}
+	after := time.Duration(r.cfg.QuotaExceededRequeueSeconds) * time.Second
+	ackrtlog.FromContext(ctx).Info(
+		"AWS service quota exceeded, requeueing",
+		"code", awsErr.Code(),
+		"after", after,
+	)
+	r.metrics.RecordQuotaExceeded(r.rd.GroupKind().Kind)
+	reason := awsErr.Message()
+	if reason == "" {
+		reason = awsErr.Code()
+	}
+	ackcondition.SetQuotaExceeded(
+		res, corev1.ConditionTrue, &ackcondition.QuotaExceededMessage, &reason,
+	)
+	ackcondition.SetSynced(
+		res, corev1.ConditionFalse, &ackcondition.NotSyncedMessage, &reason,
+	)
+	return res, requeue.NeededAfter(awsErr, after)
+}
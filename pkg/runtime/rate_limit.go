@@ -0,0 +1,64 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+)
+
+// rateLimitMaxWait is the longest time a reconciliation waits for its turn
+// to call the resource manager under --resource-rate-limits. Longer waits
+// requeue the resource instead, freeing the worker for other resources.
+const rateLimitMaxWait = 5 * time.Second
+
+// waitRateLimit blocks until the reconciler is allowed to make one more
+// resource manager call under the rate limit configured for its resource kind
+// with --resource-rate-limits.
+//
+// If the call would have to wait longer than rateLimitMaxWait, or beyond the
+// deadline of the supplied context, a requeue request for when the call is
+// allowed is returned instead. The read-backs following a Create or Update
+// are not rate limited: they are covered by the call they follow.
+func (r *resourceReconciler) waitRateLimit(ctx context.Context) error {
+	if r.rateLimiter == nil {
+		return nil
+	}
+	reservation := r.rateLimiter.Reserve()
+	delay := reservation.Delay()
+	if delay == 0 {
+		return nil
+	}
+	deadline, hasDeadline := ctx.Deadline()
+	if delay > rateLimitMaxWait || (hasDeadline && time.Now().Add(delay).After(deadline)) {
+		reservation.Cancel()
+		ackrtlog.FromContext(ctx).Info(
+			"rate limit of resource manager calls reached, requeueing",
+			"after", delay,
+		)
+		return requeue.NeededAfter(nil, delay)
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		reservation.Cancel()
+		return ctx.Err()
+	}
+}
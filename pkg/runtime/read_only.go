@@ -0,0 +1,92 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// isReadOnly returns true if the supplied resource must be reconciled in
+// read-only mode, that is if the controller was started with the --read-only
+// flag or the resource's namespace has the services.k8s.aws/read-only
+// annotation set to "true". The AWS resources of resources reconciled in
+// read-only mode are never created, updated or deleted.
+func (r *resourceReconciler) isReadOnly(res acktypes.AWSResource) bool {
+	if r.cfg.ReadOnlyMode {
+		return true
+	}
+	return r.cache.Namespaces != nil &&
+		r.cache.Namespaces.GetReadOnly(res.MetaObject().GetNamespace())
+}
+
+// failOnReadOnlyModeWrite guards the operations requiring write permissions
+// on the AWS resource. If the supplied resource is reconciled in read-only
+// mode, it sets an ACK.Terminal condition and returns a Terminal error, since
+// reaching a write operation in read-only mode is a configuration error that
+// the AWS API would otherwise reject with a confusing access denied error.
+func (r *resourceReconciler) failOnReadOnlyModeWrite(
+	ctx context.Context,
+	res acktypes.AWSResource,
+	operation string,
+) error {
+	if !r.isReadOnly(res) {
+		return nil
+	}
+	reason := ackerr.ReadOnlyModeWriteFor(operation).Error()
+	ackcondition.SetTerminal(
+		res, corev1.ConditionTrue,
+		&ackcondition.ReadOnlyModeWriteMessage, &reason,
+	)
+	ackrtlog.FromContext(ctx).Info(
+		"refusing write operation in read-only mode", "operation", operation,
+	)
+	return ackerr.Terminal
+}
+
+// reportReadOnlyDrift sets an ACK.ResourceSynced condition with a False status
+// on the supplied latest resource, listing the fields at which the AWS
+// resource differs from the desired state, instead of updating the AWS
+// resource.
+func (r *resourceReconciler) reportReadOnlyDrift(
+	ctx context.Context,
+	latest acktypes.AWSResource,
+	delta *ackcompare.Delta,
+) {
+	paths := make([]string, 0, len(delta.Differences))
+	for _, diff := range delta.Differences {
+		paths = append(paths, diff.Path.String())
+	}
+	reason := fmt.Sprintf(
+		"read-only mode: the AWS resource differs from the desired state at %s",
+		strings.Join(paths, ", "),
+	)
+	ackcondition.SetSynced(
+		latest, corev1.ConditionFalse,
+		&ackcondition.NotSyncedMessage, &reason,
+	)
+	ackrtlog.FromContext(ctx).Info(
+		"desired resource state has drifted, not updating in read-only mode",
+		"diff", delta.Differences,
+	)
+}
@@ -0,0 +1,146 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"fmt"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// ignoredStatusFields are the top-level Status fields whose changes are not
+// recorded as recent events, because they are maintained by the ACK runtime
+// itself rather than reflecting the state of the AWS resource.
+var ignoredStatusFields = map[string]bool{
+	"conditions":      true,
+	"ackRecentEvents": true,
+}
+
+// StatusTransitions returns one RecentEvent, stamped with the supplied time,
+// for each scalar Status field whose value differs between the prior and
+// latest versions of an object. The events are sorted by field path.
+// Conditions and list fields are ignored.
+func StatusTransitions(
+	prior client.Object,
+	latest client.Object,
+	now metav1.Time,
+) []*ackv1alpha1.RecentEvent {
+	priorFields := statusFields(prior)
+	latestFields := statusFields(latest)
+	paths := make([]string, 0, len(latestFields))
+	for path := range latestFields {
+		paths = append(paths, path)
+	}
+	for path := range priorFields {
+		if _, ok := latestFields[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	events := []*ackv1alpha1.RecentEvent{}
+	for _, path := range paths {
+		if priorFields[path] == latestFields[path] {
+			continue
+		}
+		events = append(events, &ackv1alpha1.RecentEvent{
+			Time:  now,
+			Field: path,
+			From:  priorFields[path],
+			To:    latestFields[path],
+		})
+	}
+	return events
+}
+
+// statusFields returns the values of the scalar Status fields of the supplied
+// object, keyed by their path in dotted JSON notation.
+func statusFields(obj client.Object) map[string]string {
+	fields := map[string]string{}
+	if obj == nil {
+		return fields
+	}
+	content, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return fields
+	}
+	status, ok := content["status"].(map[string]interface{})
+	if !ok {
+		return fields
+	}
+	for key, value := range status {
+		if ignoredStatusFields[key] {
+			continue
+		}
+		collectScalarFields("status."+key, value, fields)
+	}
+	return fields
+}
+
+// collectScalarFields adds the supplied value to fields if it is a scalar, or
+// the scalar fields nested in it if it is an object.
+func collectScalarFields(
+	path string,
+	value interface{},
+	fields map[string]string,
+) {
+	switch v := value.(type) {
+	case nil, []interface{}:
+		return
+	case map[string]interface{}:
+		for key, nested := range v {
+			collectScalarFields(path+"."+key, nested, fields)
+		}
+	default:
+		fields[path] = fmt.Sprint(v)
+	}
+}
+
+// recordRecentEvents appends the changes observed between the prior and
+// latest Status of a resource to the resource's Status.ACKRecentEvents
+// collection, keeping at most --recent-events-limit events. Resources that do
+// not implement acktypes.AWSResourceWithRecentEvents are left untouched.
+func (r *resourceReconciler) recordRecentEvents(
+	prior acktypes.AWSResource,
+	latest acktypes.AWSResource,
+) {
+	if r.cfg.RecentEventsLimit <= 0 || ackcompare.IsNil(latest) {
+		return
+	}
+	withEvents, ok := latest.(acktypes.AWSResourceWithRecentEvents)
+	if !ok {
+		return
+	}
+	transitions := StatusTransitions(
+		prior.RuntimeObject(), latest.RuntimeObject(), metav1.Now(),
+	)
+	if len(transitions) == 0 {
+		return
+	}
+	events := append(
+		append([]*ackv1alpha1.RecentEvent{}, withEvents.RecentEvents()...),
+		transitions...,
+	)
+	if len(events) > r.cfg.RecentEventsLimit {
+		events = events[len(events)-r.cfg.RecentEventsLimit:]
+	}
+	withEvents.ReplaceRecentEvents(events)
+}
@@ -0,0 +1,59 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sobj "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestStatusTransitions(t *testing.T) {
+	assert := assert.New(t)
+	now := metav1.Now()
+
+	prior := &k8sobj.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"dbInstanceStatus": "modifying",
+			"endpoint":         map[string]interface{}{"port": int64(5432)},
+			"conditions":       []interface{}{"ignored"},
+		},
+	}}
+	latest := &k8sobj.Unstructured{Object: map[string]interface{}{
+		"status": map[string]interface{}{
+			"dbInstanceStatus": "available",
+			"endpoint": map[string]interface{}{
+				"address": "mydb.example.com",
+				"port":    int64(5432),
+			},
+			"conditions": []interface{}{"also ignored"},
+		},
+	}}
+
+	events := ackrt.StatusTransitions(prior, latest, now)
+	assert.Len(events, 2)
+	assert.Equal("status.dbInstanceStatus", events[0].Field)
+	assert.Equal("modifying", events[0].From)
+	assert.Equal("available", events[0].To)
+	assert.Equal(now, events[0].Time)
+	assert.Equal("status.endpoint.address", events[1].Field)
+	assert.Equal("", events[1].From)
+	assert.Equal("mydb.example.com", events[1].To)
+
+	assert.Empty(ackrt.StatusTransitions(latest, latest.DeepCopy(), now))
+}
@@ -0,0 +1,107 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"strconv"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// ComputeReconcileCounts returns the values of the reconcile count and
+// reconcile error count annotations after a reconciliation, given the
+// annotations of the resource before the reconciliation, whether the resource
+// is synced after the reconciliation and the error returned by the
+// reconciliation.
+//
+// Both counts are reset to zero once the resource is synced or becomes
+// terminal. Otherwise the reconcile count is incremented, and the error count
+// is incremented if the reconciliation failed, that is if it returned an
+// error other than a request to requeue.
+func ComputeReconcileCounts(
+	annotations map[string]string,
+	synced bool,
+	err error,
+) (reconcileCount int, errorCount int) {
+	_, resultErr := ResultForError(err)
+	if err == ackerr.Terminal || (synced && resultErr == nil) {
+		return 0, 0
+	}
+	// Values that cannot be parsed, e.g. because users edited them, restart
+	// the count from zero.
+	reconcileCount, _ = strconv.Atoi(annotations[ackv1alpha1.AnnotationReconcileCount])
+	errorCount, _ = strconv.Atoi(annotations[ackv1alpha1.AnnotationReconcileErrorCount])
+	reconcileCount++
+	if resultErr != nil {
+		errorCount++
+	}
+	return reconcileCount, errorCount
+}
+
+// updateReconcileCountAnnotations patches the reconcile count annotations of
+// the supplied resource to reflect the outcome of the latest reconciliation.
+//
+// Failures to patch the annotations are logged and otherwise ignored, since
+// the annotations are purely informational.
+func (r *resourceReconciler) updateReconcileCountAnnotations(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+	reconcileErr error,
+) {
+	if !r.cfg.EnableReconcileCountAnnotations {
+		return
+	}
+	synced := ackcompare.IsNotNil(latest) && IsSynced(latest)
+	reconcileCount, errorCount := ComputeReconcileCounts(
+		desired.MetaObject().GetAnnotations(), synced, reconcileErr,
+	)
+
+	res := desired.DeepCopy()
+	orig := res.DeepCopy().RuntimeObject()
+	annotations := res.MetaObject().GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	setOrDelete := func(key string, count int) {
+		if count == 0 {
+			delete(annotations, key)
+		} else {
+			annotations[key] = strconv.Itoa(count)
+		}
+	}
+	setOrDelete(ackv1alpha1.AnnotationReconcileCount, reconcileCount)
+	setOrDelete(ackv1alpha1.AnnotationReconcileErrorCount, errorCount)
+	res.MetaObject().SetAnnotations(annotations)
+
+	equalMetadata, err := ackcompare.MetaV1ObjectEqual(
+		desired.MetaObject(), res.MetaObject(),
+	)
+	if err != nil || equalMetadata {
+		return
+	}
+	if err = r.kc.Patch(ctx, res.RuntimeObject(), client.MergeFrom(orig)); err != nil {
+		ackrtlog.FromContext(ctx).Debug(
+			"failed to update reconcile count annotations",
+			"error", err,
+		)
+	}
+}
@@ -17,18 +17,25 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/rand"
+	"regexp"
 	"strings"
 	"time"
 
 	backoff "github.com/cenkalti/backoff/v4"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"golang.org/x/time/rate"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
 	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcompare "github.com/aws-controllers-k8s/runtime/pkg/compare"
@@ -37,6 +44,7 @@ import (
 	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
 	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
 	ackmetrics "github.com/aws-controllers-k8s/runtime/pkg/metrics"
+	ackpolicy "github.com/aws-controllers-k8s/runtime/pkg/policy"
 	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
 	ackrtcache "github.com/aws-controllers-k8s/runtime/pkg/runtime/cache"
 	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
@@ -51,6 +59,15 @@ const (
 	defaultResyncPeriod = 10 * time.Hour
 )
 
+const (
+	// The operations performed against the backend AWS resource during
+	// reconciliation. These are used to label reconcile error metrics and to
+	// give context to the reasons of the conditions set on failures.
+	operationCreate = "create"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
 // reconciler describes a generic reconciler within ACK.
 type reconciler struct {
 	sc        acktypes.ServiceController
@@ -74,6 +91,67 @@ type resourceReconciler struct {
 	rmf          acktypes.AWSResourceManagerFactory
 	rd           acktypes.AWSResourceDescriptor
 	resyncPeriod time.Duration
+	// resyncResolver is consulted for the resync period of each resource when
+	// the resource manager factory implements acktypes.ResyncPeriodResolver.
+	// When nil, resyncPeriod is used for all resources.
+	resyncResolver acktypes.ResyncPeriodResolver
+	// patchLatency tracks the latency of the patches made to the Kubernetes
+	// API server in order to apply backpressure to requeues when the API
+	// server is slow.
+	patchLatency *patchLatencyTracker
+	// errorSeverities maps AWS error codes to the severity with which they
+	// are handled, overriding the built-in handling of these errors.
+	errorSeverities map[string]ackcfg.AWSErrorSeverity
+	// policyChecker, when not nil, decides whether resources may be created
+	// or updated.
+	policyChecker *ackpolicy.Checker
+	// protectedClearedFields are the paths of the Spec fields that must not
+	// be cleared without confirmation.
+	protectedClearedFields []string
+	// namePattern, when not nil, is the regular expression the names of the
+	// AWS resources must match.
+	namePattern *regexp.Regexp
+	// createSlots, when not nil, limits the number of resources created and
+	// managed by the reconciler.
+	createSlots *createSlotTracker
+	// requiredFields are the values, keyed by path in dotted JSON notation,
+	// that the fields of the AWS resources must have.
+	requiredFields map[string]string
+	// costs tracks the estimated monthly cost of the AWS resources of the
+	// reconciled resources.
+	costs *costTracker
+	// accessDenied, when not nil, detects regressions of the controller's
+	// permissions.
+	accessDenied *accessDeniedDetector
+	// rateLimiter, when not nil, limits the rate of the resource manager
+	// calls made while reconciling resources.
+	rateLimiter *rate.Limiter
+	// loggerFields are the labels and annotations of the reconciled resources
+	// added as fields to the resource loggers.
+	loggerFields []ackcfg.ResourceLoggerField
+	// references tracks the resources referred to by the reconciled
+	// resources, so that referring resources are reconciled again when the
+	// fields they depend on change.
+	references *referenceIndex
+	// secretReferences tracks the Secrets read by the reconciled resources,
+	// so that resources are reconciled again when their Secrets change.
+	secretReferences *referenceIndex
+	// resyncs tracks the next resync scheduled for each resource, so that
+	// redundant resyncs can be skipped.
+	resyncs *resyncTracker
+	// encryptedStatusFields are the paths of the Status fields encrypted with
+	// statusEncryptionKey before the Status is patched.
+	encryptedStatusFields []string
+	statusEncryptionKey   []byte
+	// sessions, when not nil, caches the AWS sessions used to reconcile
+	// resources.
+	sessions *sessionCache
+	// transitions, when not nil, records the transitions of the statuses of
+	// the conditions of each resource.
+	transitions *conditionTransitionLog
+	// forcedReconciles tracks the services.k8s.aws/force-reconcile
+	// annotations handled for each resource.
+	forcedReconciles *forcedReconcileTracker
 }
 
 // GroupKind returns the string containing the API group and kind reconciled by
@@ -94,13 +172,51 @@ func (r *resourceReconciler) BindControllerManager(mgr ctrlrt.Manager) error {
 	r.kc = mgr.GetClient()
 	r.apiReader = mgr.GetAPIReader()
 	rd := r.rmf.ResourceDescriptor()
-	return ctrlrt.NewControllerManagedBy(
+	bldr := ctrlrt.NewControllerManagedBy(
 		mgr,
 	).For(
 		rd.EmptyRuntimeObject(),
-	).WithEventFilter(
-		predicate.GenerationChangedPredicate{},
-	).Complete(r)
+		// Only generation changes and changes to the force-reconcile
+		// annotation trigger reconciliations. Changes to other annotations,
+		// and to labels, do not.
+		builder.WithPredicates(predicate.Or(
+			predicate.GenerationChangedPredicate{},
+			ForceReconcilePredicate{},
+		)),
+	).WithOptions(controller.Options{
+		MaxConcurrentReconciles: r.cfg.GetMaxConcurrentReconciles(rd.GroupKind().Kind),
+	})
+	// Reconcile resources again when the resources they reference change.
+	// Only resources whose kinds are managed by this service controller, and
+	// enabled with --reference-watch-kinds, are watched.
+	if _, ok := rd.(acktypes.AWSResourceReferenceDescriptor); ok && r.sc != nil {
+		for _, rmf := range r.sc.GetResourceManagerFactories() {
+			refRD := rmf.ResourceDescriptor()
+			if !r.cfg.ReferenceWatchEnabled(refRD.GroupKind().Kind) {
+				continue
+			}
+			bldr = bldr.Watches(
+				&source.Kind{Type: refRD.EmptyRuntimeObject()},
+				&referenceEventHandler{
+					groupKind: *refRD.GroupKind(),
+					index:     r.references,
+					resyncs:   r.resyncs,
+				},
+			)
+		}
+	}
+	// Reconcile resources again when the Secrets they read change.
+	if r.cfg.WatchReferencedSecrets {
+		bldr = bldr.Watches(
+			&source.Kind{Type: &corev1.Secret{}},
+			&referenceEventHandler{
+				groupKind: secretGroupKind,
+				index:     r.secretReferences,
+				resyncs:   r.resyncs,
+			},
+		)
+	}
+	return bldr.Complete(r)
 }
 
 // SecretValueFromReference fetches the value of a Secret given a
@@ -144,37 +260,167 @@ func (r *reconciler) SecretValueFromReference(
 // Reconcile implements `controller-runtime.Reconciler` and handles reconciling
 // a CR CRUD request
 func (r *resourceReconciler) Reconcile(ctx context.Context, req ctrlrt.Request) (ctrlrt.Result, error) {
+	if r.metrics != nil {
+		kind := r.rd.GroupKind().Kind
+		r.metrics.AddInflightReconciles(kind, 1)
+		defer r.metrics.AddInflightReconciles(kind, -1)
+	}
 	desired, err := r.getAWSResource(ctx, req)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
 			// resource wasn't found. just ignore these.
+			r.references.remove(req.NamespacedName)
+			r.secretReferences.remove(req.NamespacedName)
+			r.resyncs.forget(req.NamespacedName)
+			r.forgetCost(req.NamespacedName)
+			r.forcedReconciles.forget(req.NamespacedName)
+			if r.transitions != nil {
+				r.transitions.forget(req.NamespacedName)
+			}
 			return ctrlrt.Result{}, nil
 		}
+		if IsConversionWebhookError(err) {
+			// The conversion webhook is typically briefly unavailable while
+			// the controller or its CRDs are upgraded. Retry later instead of
+			// failing the reconciliation.
+			r.log.Info(
+				"CRD conversion webhook unavailable, requeueing",
+				"kind", r.rd.GroupKind().Kind,
+				"namespace", req.Namespace,
+				"name", req.Name,
+				"error", err.Error(),
+				"after", requeue.DefaultRequeueAfterDuration,
+			)
+			return ctrlrt.Result{RequeueAfter: requeue.DefaultRequeueAfterDuration}, nil
+		}
 		return ctrlrt.Result{}, err
 	}
 
-	acctID := r.getOwnerAccountID(desired)
-	region := r.getRegion(desired)
+	if !r.matchesCanaryAnnotation(desired) {
+		// The resource belongs to another version of the controller. Leave it,
+		// including its finalizers, entirely alone.
+		r.log.V(1).Info(
+			"ignoring resource without matching canary annotation",
+			"kind", r.rd.GroupKind().Kind,
+			"namespace", req.Namespace,
+			"name", req.Name,
+		)
+		return ctrlrt.Result{}, nil
+	}
+
+	if IsPaused(desired) {
+		return r.handlePaused(ctx, req, desired)
+	}
+
+	// A change to the force-reconcile annotation asks for a full
+	// reconciliation, which is neither coalesced nor delayed by a backoff.
+	forced := r.forcedReconciles.requested(req.NamespacedName, desired)
+	if forced {
+		r.log.V(1).Info(
+			"forcing reconciliation of resource",
+			"kind", r.rd.GroupKind().Kind,
+			"namespace", req.Namespace,
+			"name", req.Name,
+		)
+	}
+
+	// Skip resyncs made redundant by a more recent successful reconciliation
+	// of the same generation of the resource.
+	if r.cfg.EnableResyncCoalescing && !forced {
+		remaining, ok := r.resyncs.pending(
+			req.NamespacedName, desired.MetaObject().GetGeneration(), time.Now(),
+		)
+		if ok {
+			r.log.V(1).Info(
+				"skipping redundant resync of resource",
+				"kind", r.rd.GroupKind().Kind,
+				"namespace", req.Namespace,
+				"name", req.Name,
+				"remaining", remaining,
+			)
+			return ctrlrt.Result{RequeueAfter: remaining}, nil
+		}
+	}
+
+	// Resume the backoff of resources that kept failing to reconcile before
+	// the controller restarted, rather than retrying all of them at once.
+	if remaining := r.remainingBackoff(desired); remaining > 0 && !forced {
+		r.log.V(1).Info(
+			"delaying reconciliation of resource in backoff",
+			"kind", r.rd.GroupKind().Kind,
+			"namespace", req.Namespace,
+			"name", req.Name,
+			"remaining", remaining,
+		)
+		return ctrlrt.Result{RequeueAfter: remaining}, nil
+	}
+
+	// Stop making requests bound to be denied while the controller's
+	// permissions are broken.
+	if remaining := r.accessDeniedPause(); remaining > 0 {
+		r.log.V(1).Info(
+			"delaying reconciliation of resource while permissions are denied",
+			"kind", r.rd.GroupKind().Kind,
+			"namespace", req.Namespace,
+			"name", req.Name,
+			"remaining", remaining,
+		)
+		return ctrlrt.Result{RequeueAfter: remaining}, nil
+	}
+
+	// Keep the reconciliations of all the resources of the AWS service within
+	// the concurrency configured for the service.
+	release, err := r.acquireServiceSlot(ctx)
+	if err != nil {
+		return ctrlrt.Result{}, err
+	}
+	defer release()
+
+	if IsExplainEnabled(desired) {
+		ctx = WithExplanation(ctx)
+	}
+	acctID, acctSource := r.resolveOwnerAccountID(desired)
+	if acctID == "" {
+		return r.failOnUnresolvedAccount(ctx, req, desired)
+	}
+	Explain(ctx, explainStepAccount, "using account %q from the %s", acctID, acctSource)
+	region, regionSource := r.resolveRegion(desired)
+	if annotated, conflict := r.regionConflict(desired); conflict {
+		return r.failOnRegionConflict(ctx, req, desired, region, annotated)
+	}
+	Explain(ctx, explainStepRegion, "using region %q from the %s", region, regionSource)
 	roleARN := r.getRoleARN(acctID)
 	endpointURL := r.getEndpointURL(desired)
-	gvk := desired.RuntimeObject().GetObjectKind().GroupVersionKind()
-	sess, err := r.sc.NewSession(region, &endpointURL, roleARN, gvk)
+	sessKey := sessionCacheKey{
+		account:  acctID,
+		region:   region,
+		roleARN:  roleARN,
+		endpoint: endpointURL,
+		gvk:      desired.RuntimeObject().GetObjectKind().GroupVersionKind(),
+	}
+	sess, err := r.getSession(sessKey)
 	if err != nil {
 		return ctrlrt.Result{}, err
 	}
 
 	rlog := ackrtlog.NewResourceLogger(
 		r.log, desired,
-		"account", acctID,
-		"role", roleARN,
-		"region", region,
-		// All the fields for a resource that do not change during reconciliation
-		// can be initialized during resourceLogger creation
-		"kind", r.rd.GroupKind().Kind,
-		"namespace", req.Namespace,
-		"name", req.Name,
+		append([]interface{}{
+			"account", acctID,
+			"role", roleARN,
+			"region", region,
+			// All the fields for a resource that do not change during reconciliation
+			// can be initialized during resourceLogger creation
+			"kind", r.rd.GroupKind().Kind,
+			"namespace", req.Namespace,
+			"name", req.Name,
+		}, r.resourceLoggerFields(desired)...)...,
 	)
 	ctx = context.WithValue(ctx, ackrtlog.ContextKey, rlog)
+	ctx, span := r.startReconcileSpan(
+		ctx, req.Namespace, req.Name, string(acctID), string(region),
+	)
+	defer span.End()
 
 	rm, err := r.rmf.ManagerFor(
 		r.cfg, r.log, r.metrics, r, sess, acctID, region,
@@ -182,8 +428,53 @@ func (r *resourceReconciler) Reconcile(ctx context.Context, req ctrlrt.Request)
 	if err != nil {
 		return ctrlrt.Result{}, err
 	}
-	latest, err := r.reconcile(ctx, rm, desired)
-	return r.HandleReconcileError(ctx, desired, latest, err)
+	ctx, finishTracking := r.trackReconcile(ctx, req.NamespacedName)
+	ctx = withReferrer(ctx, req.NamespacedName)
+	r.metrics.RecordReconcile(r.rd.GroupKind().Kind)
+	priorConditions := r.snapshotConditions(desired)
+	housekeepingConditions := r.snapshotHousekeepingConditions(desired)
+	r.pruneStaleAnnotations(ctx, desired)
+	latest, err := r.reconcileRecovering(ctx, rm, desired)
+	r.normalizeConditionTimes(housekeepingConditions, latest)
+	r.recordConditionTransitions(
+		ctx, req.NamespacedName, priorConditions, desired, latest,
+	)
+	r.invalidateSession(sessKey, err)
+	r.observeAccessDenied(req.NamespacedName, err)
+	r.updateReconcileCountAnnotations(ctx, desired, latest, err)
+	r.updateBackoffState(ctx, desired, err)
+	result, err := r.HandleReconcileError(ctx, desired, latest, err)
+	r.recordResync(req.NamespacedName, desired, latest, result, err)
+	r.forcedReconciles.record(req.NamespacedName, desired)
+	explainResult(ctx, result, err)
+	r.writeExplanation(ctx, desired)
+	setSpanError(span, err)
+	finishTracking(result, err)
+	return result, err
+}
+
+// recordResync records the resync scheduled for a resource by a successful
+// reconciliation, or forgets any resync recorded for the resource if the
+// reconciliation did not bring the resource in sync.
+func (r *resourceReconciler) recordResync(
+	nn k8stypes.NamespacedName,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+	result ctrlrt.Result,
+	err error,
+) {
+	if !r.cfg.EnableResyncCoalescing {
+		return
+	}
+	if err != nil || result.RequeueAfter <= 0 ||
+		ackcompare.IsNil(latest) || !IsSynced(latest) {
+		r.resyncs.forget(nn)
+		return
+	}
+	r.resyncs.record(
+		nn, desired.MetaObject().GetGeneration(),
+		time.Now().Add(result.RequeueAfter),
+	)
 }
 
 // reconcile either cleans up a deleted resource or ensures that the supplied
@@ -196,22 +487,64 @@ func (r *resourceReconciler) reconcile(
 	res acktypes.AWSResource,
 ) (acktypes.AWSResource, error) {
 	if res.IsBeingDeleted() {
-		// Determine whether we should retain or delete the resource
-		if r.getDeletionPolicy(res) == ackv1alpha1.DeletionPolicyDelete {
+		// Determine whether we should retain or delete the resource. AWS
+		// resources are always retained in read-only mode.
+		deletionPolicy := r.getDeletionPolicy(res)
+		if (deletionPolicy == ackv1alpha1.DeletionPolicyDelete ||
+			deletionPolicy == ackv1alpha1.DeletionPolicySoftDelete) && !r.isReadOnly(res) {
+			if IsDeletionProtected(res) {
+				// Annotation changes do not trigger reconciliations, so keep
+				// checking whether the protection was lifted.
+				rlog := ackrtlog.FromContext(ctx)
+				rlog.Info("AWS resource will not be deleted - deletion protection is enabled")
+				ackcondition.SetAdvisory(
+					res, corev1.ConditionTrue,
+					&ackcondition.DeletionProtectedMessage,
+					&ackcondition.DeletionProtectedReason,
+				)
+				return res, requeue.NeededAfter(nil, requeue.DefaultRequeueAfterDuration)
+			}
+			if deletionPolicy == ackv1alpha1.DeletionPolicySoftDelete {
+				latest, expired, err := r.softDeleteResource(ctx, rm, res)
+				if !expired {
+					if err != nil {
+						return latest, err
+					}
+					return r.handleRequeues(ctx, latest)
+				}
+			}
 			// Resolve references before deleting the resource.
 			// Ignore any errors while resolving the references
-			res, _ = rm.ResolveReferences(ctx, r.apiReader, res)
-			return r.deleteResource(ctx, rm, res)
+			res, _ = r.resolveReferences(ctx, rm, res)
+			setReconcilePhase(ctx, reconcilePhaseDelete)
+			latest, err := r.deleteResource(ctx, rm, res)
+			latest, err = r.applyAWSErrorSeverity(ctx, res, latest, err)
+			r.recordReconcileError(operationDelete, err)
+			return latest, err
 		}
 
+		// With both the "retain" and "orphan" deletion policies, removing the
+		// finalizer lets Kubernetes delete the CR while the AWS resource is
+		// left intact.
 		rlog := ackrtlog.FromContext(ctx)
-		rlog.Info("AWS resource will not be deleted - deletion policy set to retain")
+		switch {
+		case r.isReadOnly(res):
+			rlog.Info("AWS resource will not be deleted - read-only mode")
+		case deletionPolicy == ackv1alpha1.DeletionPolicyOrphan:
+			rlog.Info("AWS resource will not be deleted - deletion policy set to orphan")
+		default:
+			rlog.Info("AWS resource will not be deleted - deletion policy set to retain")
+		}
 		if err := r.setResourceUnmanaged(ctx, res); err != nil {
 			return res, err
 		}
 		return r.handleRequeues(ctx, res)
 	}
 	latest, err := r.Sync(ctx, rm, res)
+	latest, err = r.applyAWSErrorSeverity(ctx, res, latest, err)
+	latest, err = r.handleQuotaExceeded(ctx, res, latest, err)
+	latest, err = r.handleMissingSecret(ctx, res, latest, err)
+	r.recordRecentEvents(res, latest)
 	if err != nil {
 		return latest, err
 	}
@@ -235,56 +568,218 @@ func (r *resourceReconciler) Sync(
 	}()
 
 	var latest acktypes.AWSResource // the newly created or mutated resource
+	// operation is set to the operation being performed against the backend
+	// AWS resource while that operation is in progress
+	var operation string
 
 	r.resetConditions(ctx, desired)
 	defer func() {
-		r.ensureConditions(ctx, rm, latest, err)
+		r.recordReconcileError(operation, err)
+		r.ensureConditions(ctx, rm, latest, operation, err)
+		r.propagateTagsApplied(desired, latest)
 	}()
 
 	isAdopted := IsAdopted(desired)
 	rlog.WithValues("is_adopted", isAdopted)
 
+	if err = r.failOnMissingRequiredAnnotations(ctx, desired); err != nil {
+		return desired, err
+	}
+
+	// Compute the resource's name, if it was omitted, before resolving
+	// references because patching the name back to the Kubernetes API
+	// overwrites any resolved references.
+	if !isAdopted {
+		if err = r.ensureResourceName(ctx, desired); err != nil {
+			return desired, err
+		}
+	}
+
+	if err = r.failOnNamingPolicyViolation(desired); err != nil {
+		return desired, err
+	}
+
+	r.indexReferences(desired)
+
+	if r.cfg.EnableReferenceCycleDetection {
+		if err = r.failOnReferenceCycle(ctx, desired); err != nil {
+			return desired, err
+		}
+	}
+
+	if r.cfg.EnforceSameLocationReferences {
+		if err = r.failOnReferenceLocationMismatch(ctx, desired); err != nil {
+			return desired, err
+		}
+	}
+
+	setReconcilePhase(ctx, reconcilePhaseResolveReferences)
 	rlog.Enter("rm.ResolveReferences")
-	resolvedRefDesired, err := rm.ResolveReferences(ctx, r.apiReader, desired)
+	endOperation := r.startOperation(ctx, "ResolveReferences")
+	resolvedRefDesired, err := r.resolveReferences(ctx, rm, desired)
+	endOperation(err)
 	rlog.Exit("rm.ResolveReferences", err)
 	if err != nil {
 		return resolvedRefDesired, err
 	}
 	desired = resolvedRefDesired
 
-	rlog.Enter("rm.EnsureTags")
-	err = rm.EnsureTags(ctx, desired, r.sc.GetMetadata())
-	rlog.Exit("rm.EnsureTags", err)
-	if err != nil {
+	if err = r.failOnReferenceMismatch(ctx, rm, desired); err != nil {
 		return desired, err
 	}
 
-	rlog.Enter("rm.ReadOne")
-	latest, err = rm.ReadOne(ctx, desired)
-	rlog.Exit("rm.ReadOne", err)
+	if err = r.syncLabelTags(ctx, desired); err != nil {
+		return desired, err
+	}
+
+	tagOnCreate := r.tagOnCreate()
+	if tagOnCreate {
+		if err = r.ensureTags(ctx, rm, desired); err != nil {
+			return desired, err
+		}
+	}
+
+	setReconcilePhase(ctx, reconcilePhaseRead)
+	if !isAdopted && r.canSkipInitialRead(desired) {
+		// The AWS resource cannot exist yet, since its client-assigned
+		// identifier was never used to create it.
+		Explain(ctx, explainStepRead, "new resource with a client-assigned identifier, skipping the initial read")
+		latest, err = nil, ackerr.NotFound
+	} else {
+		if err = r.waitRateLimit(ctx); err != nil {
+			return desired, err
+		}
+		rlog.Enter("rm.ReadOne")
+		endOperation = r.startOperation(ctx, "ReadOne")
+		latest, err = rm.ReadOne(ctx, desired)
+		endOperation(err)
+		rlog.Exit("rm.ReadOne", err)
+	}
 	if err != nil {
 		if err != ackerr.NotFound {
 			return latest, err
 		}
 		if isAdopted {
+			Explain(ctx, explainStepRead, "adopted AWS resource not found")
+			if r.cfg.RequeueAdoptedResourceNotFound {
+				// The AWS resource may still be being created by another
+				// process. Wait for it rather than failing.
+				latest = desired
+				ackcondition.SetSynced(
+					latest, corev1.ConditionFalse,
+					&ackcondition.NotSyncedMessage,
+					&ackcondition.AdoptedResourceNotFoundReason,
+				)
+				return latest, requeue.NeededAfter(
+					ackerr.AdoptedResourceNotFound,
+					time.Duration(r.cfg.AdoptedNotFoundRequeueSeconds)*time.Second,
+				)
+			}
 			return nil, ackerr.AdoptedResourceNotFound
 		}
+		if r.isReadOnly(desired) {
+			Explain(ctx, explainStepRead, "AWS resource not found, not creating it in read-only mode")
+			// Report the missing AWS resource instead of creating it.
+			latest = desired
+			ackcondition.SetSynced(
+				latest, corev1.ConditionFalse,
+				&ackcondition.NotSyncedMessage,
+				&ackcondition.ReadOnlyModeNotFoundReason,
+			)
+			return latest, nil
+		}
+		if IsCreateInProgress(desired) {
+			Explain(ctx, explainStepRead, "AWS resource not found, its asynchronous creation is still in progress")
+			latest = desired
+			return latest, r.requeueCreateInProgress(latest)
+		}
+		if r.cfg.OnExternalDeletion == ackcfg.OnExternalDeletionTerminal &&
+			r.wasExternallyDeleted(desired) {
+			Explain(ctx, explainStepRead, "AWS resource deleted outside of the controller, not creating it again")
+			latest = desired
+			ackcondition.SetTerminal(
+				latest, corev1.ConditionTrue,
+				&ackcondition.ExternallyDeletedMessage,
+				&ackcondition.ExternallyDeletedReason,
+			)
+			ackcondition.SetSynced(latest, corev1.ConditionFalse, nil, nil)
+			return latest, ackerr.Terminal
+		}
+		Explain(ctx, explainStepRead, "AWS resource not found, creating it")
+		operation = operationCreate
+		setReconcilePhase(ctx, reconcilePhaseCreate)
 		if latest, err = r.createResource(ctx, rm, desired); err != nil {
 			return latest, err
 		}
+		if !tagOnCreate {
+			operation = operationUpdate
+			if latest, err = r.tagAfterCreate(ctx, rm, latest); err != nil {
+				return latest, err
+			}
+		}
 	} else {
+		Explain(ctx, explainStepRead, "AWS resource found, comparing it with the desired state")
+		r.confirmCreateSlot(desired)
+		if err = r.checkRequiredFields(ctx, desired, latest); err != nil {
+			return latest, err
+		}
+		if !tagOnCreate {
+			if err = r.ensureTags(ctx, rm, desired); err != nil {
+				return desired, err
+			}
+		}
+		operation = operationUpdate
+		setReconcilePhase(ctx, reconcilePhaseUpdate)
 		if latest, err = r.updateResource(ctx, rm, desired, latest); err != nil {
 			return latest, err
 		}
+		if err = r.clearCreateInProgress(ctx, latest); err != nil {
+			return latest, err
+		}
 	}
+	operation = ""
 	// Attempt to late initialize the resource. If there are no fields to
 	// late initialize, this operation will be a no-op.
+	setReconcilePhase(ctx, reconcilePhaseLateInitialize)
 	if latest, err = r.lateInitializeResource(ctx, rm, latest); err != nil {
 		return latest, err
 	}
+	r.computeDerivedStatus(ctx, rm, latest)
 	return latest, nil
 }
 
+// canSkipInitialRead returns true if the read preceding the creation of the
+// supplied resource can be skipped. This is the case for brand-new resources,
+// which were never managed and have no ACKResourceMetadata in their Status,
+// of the kinds enabled with --skip-initial-read-kinds whose descriptor
+// declares a client-assigned identifier.
+func (r *resourceReconciler) canSkipInitialRead(
+	res acktypes.AWSResource,
+) bool {
+	if !r.cfg.SkipInitialReadEnabled(r.rd.GroupKind().Kind) {
+		return false
+	}
+	descriptor, ok := r.rd.(acktypes.AWSResourceClientAssignedIdentifierDescriptor)
+	if !ok || !descriptor.HasClientAssignedIdentifier() {
+		return false
+	}
+	if r.isManaged(res) {
+		// A previous attempt to create the AWS resource may have succeeded
+		return false
+	}
+	ids := res.Identifiers()
+	return ids.ARN() == nil && ids.OwnerAccountID() == nil
+}
+
+// wasExternallyDeleted returns true if the supplied managed resource, whose
+// AWS resource was not found, refers to an AWS resource that was created
+// before, meaning it was deleted outside of the controller.
+func (r *resourceReconciler) wasExternallyDeleted(
+	res acktypes.AWSResource,
+) bool {
+	return r.isManaged(res) && res.Identifiers().ARN() != nil
+}
+
 // resetConditions strips the supplied resource of all objects in its
 // Status.Conditions collection. We do this at the start of each reconciliation
 // loop in order to ensure that the objects in the Status.Conditions collection
@@ -306,11 +801,17 @@ func (r *resourceReconciler) resetConditions(
 }
 
 // ensureConditions examines the supplied resource's collection of Condition
-// objects and ensures that an ACK.ResourceSynced condition is present.
+// objects and ensures that an ACK.ResourceSynced condition is present. When
+// enabled, it also sets the ACK.ReconcileSummary condition.
+//
+// If the reconciliation failed while performing an operation against the
+// backend AWS resource, the name of that operation is included in the reason
+// of the ACK.ResourceSynced condition.
 func (r *resourceReconciler) ensureConditions(
 	ctx context.Context,
 	rm acktypes.AWSResourceManager,
 	res acktypes.AWSResource,
+	operation string,
 	reconcileErr error,
 ) {
 	if ackcompare.IsNil(res) {
@@ -342,6 +843,9 @@ func (r *resourceReconciler) ensureConditions(
 
 		if reconcileErr != nil {
 			condReason = reconcileErr.Error()
+			if operation != "" {
+				condReason = fmt.Sprintf("%s failed: %s", operation, condReason)
+			}
 			if reconcileErr == ackerr.Terminal {
 				// A terminal condition is a stable state for a resource.
 				// Terminal conditions indicate that without changes to the
@@ -360,6 +864,104 @@ func (r *resourceReconciler) ensureConditions(
 		}
 		ackcondition.SetSynced(res, condStatus, &condMessage, &condReason)
 	}
+
+	if r.cfg.EnableReconcileSummary {
+		status, summary := reconcileSummary(res, operation, reconcileErr)
+		ackcondition.SetReconcileSummary(res, status, summary)
+	}
+}
+
+// reconcileSummary returns the status and the one-line summary of the
+// ACK.ReconcileSummary condition for a resource, based on the resource's
+// other conditions and the error returned from the reconciliation, e.g.
+// "synced; last checked at 2023-01-01T00:00:00Z" or "create failed: quota
+// exceeded".
+func reconcileSummary(
+	res acktypes.AWSResource,
+	operation string,
+	reconcileErr error,
+) (corev1.ConditionStatus, string) {
+	if terminal := ackcondition.Terminal(res); terminal != nil &&
+		terminal.Status == corev1.ConditionTrue {
+		summary := "terminal"
+		if terminal.Message != nil && *terminal.Message != "" {
+			summary += ": " + *terminal.Message
+		}
+		return corev1.ConditionFalse, summary
+	}
+	if reconcileErr != nil && reconcileErr != ackerr.Terminal {
+		if operation == "" {
+			operation = "reconcile"
+		}
+		return corev1.ConditionUnknown, fmt.Sprintf("%s failed: %s", operation, reconcileErr)
+	}
+	checkedAt := time.Now().UTC().Format(time.RFC3339)
+	if synced := ackcondition.Synced(res); synced != nil && synced.Status == corev1.ConditionTrue {
+		return corev1.ConditionTrue, "synced; last checked at " + checkedAt
+	}
+	return corev1.ConditionFalse, "not synced; last checked at " + checkedAt
+}
+
+// recordReconcileError increments the reconcile error metric for the
+// reconciler's resource kind if the supplied error was returned while
+// performing the supplied operation against the backend AWS resource.
+//
+// Requeue requests that do not wrap an error are not considered failures.
+func (r *resourceReconciler) recordReconcileError(
+	operation string,
+	err error,
+) {
+	if err == nil || operation == "" || r.metrics == nil {
+		return
+	}
+	if isRequeueOnly(err) {
+		return
+	}
+	r.metrics.RecordReconcileError(r.rd.GroupKind().Kind, operation)
+}
+
+// isRequeueOnly returns true if the supplied error is a requeue request that
+// does not wrap an error, e.g. the one returned while an asynchronous delete
+// is still in progress.
+func isRequeueOnly(err error) bool {
+	var requeueNeeded *requeue.RequeueNeeded
+	if errors.As(err, &requeueNeeded) && requeueNeeded.Unwrap() == nil {
+		return true
+	}
+	var requeueNeededAfter *requeue.RequeueNeededAfter
+	if errors.As(err, &requeueNeededAfter) && requeueNeededAfter.Unwrap() == nil {
+		return true
+	}
+	return false
+}
+
+// recordOperation increments the resource operation metric for the supplied
+// create, update or delete operation, with an outcome of error if the
+// resource manager returned an error that is not just a requeue request.
+func (r *resourceReconciler) recordOperation(
+	operation string,
+	err error,
+) {
+	if r.metrics == nil {
+		return
+	}
+	outcome := ackmetrics.OperationOutcomeSuccess
+	if err != nil && !isRequeueOnly(err) {
+		outcome = ackmetrics.OperationOutcomeError
+	}
+	r.metrics.RecordOperation(r.rd.GroupKind().Kind, operation, outcome)
+}
+
+// recordOperationDuration records the time elapsed since the supplied start
+// time as the duration of the supplied resource manager operation.
+func (r *resourceReconciler) recordOperationDuration(
+	operation string,
+	start time.Time,
+) {
+	if r.metrics == nil {
+		return
+	}
+	r.metrics.RecordOperationDuration(r.rd.GroupKind().Kind, operation, time.Since(start))
 }
 
 // createResource marks the CR as managed by ACK, calls one or more AWS APIs to
@@ -388,12 +990,16 @@ func (r *resourceReconciler) createResource(
 
 	var latest acktypes.AWSResource // the newly created resource
 
+	if err = r.failOnReadOnlyModeWrite(ctx, desired, operationCreate); err != nil {
+		return desired, err
+	}
+
 	// Before we create the backend AWS service resources, let's first mark
 	// the CR as being managed by ACK. Internally, this means adding a
 	// finalizer to the CR; a finalizer that is removed once ACK no longer
 	// manages the resource OR if the backend AWS service resource is
 	// properly deleted.
-	if !r.rd.IsManaged(desired) {
+	if !r.isManaged(desired) {
 		if err = r.setResourceManaged(ctx, desired); err != nil {
 			return nil, err
 		}
@@ -403,7 +1009,9 @@ func (r *resourceReconciler) createResource(
 		// because they are not persisted in etcd. So we resolve the references
 		// again before performing the create operation.
 		rlog.Enter("rm.ResolveReferences")
-		resolvedRefDesired, err := rm.ResolveReferences(ctx, r.apiReader, desired)
+		endOperation := r.startOperation(ctx, "ResolveReferences")
+		resolvedRefDesired, err := r.resolveReferences(ctx, rm, desired)
+		endOperation(err)
 		rlog.Exit("rm.ResolveReferences", err)
 		if err != nil {
 			return resolvedRefDesired, err
@@ -414,23 +1022,50 @@ func (r *resourceReconciler) createResource(
 		// resource. Patching desired resource omits the controller tags
 		// because they are not persisted in etcd. So we again ensure
 		// that tags are present before performing the create operation.
-		rlog.Enter("rm.EnsureTags")
-		err = rm.EnsureTags(ctx, desired, r.sc.GetMetadata())
-		rlog.Exit("rm.EnsureTags", err)
-		if err != nil {
-			return desired, err
+		if r.tagOnCreate() {
+			if err = r.ensureTags(ctx, rm, desired); err != nil {
+				return desired, err
+			}
 		}
 	}
 
+	if err = r.checkPolicy(ctx, desired, desired, operationCreate); err != nil {
+		return desired, err
+	}
+
+	if err = r.reserveCreateSlot(ctx, desired); err != nil {
+		return desired, err
+	}
+
+	if err = r.waitRateLimit(ctx); err != nil {
+		return desired, err
+	}
 	rlog.Enter("rm.Create")
+	endOperation := r.startOperation(ctx, "Create")
 	latest, err = rm.Create(ctx, desired)
+	endOperation(err)
+	r.recordOperation(operationCreate, err)
 	rlog.Exit("rm.Create", err)
+	r.settleCreateSlot(desired, err)
 	if err != nil {
+		if named, ok := desired.(acktypes.AWSResourceWithSpecName); ok && isNameConflict(err) {
+			reason := fmt.Sprintf(
+				"an AWS resource named %q already exists: %s",
+				named.SpecName(), err.Error(),
+			)
+			ackcondition.SetTerminal(
+				desired, corev1.ConditionTrue,
+				&ackcondition.NameConflictMessage, &reason,
+			)
+			return desired, ackerr.Terminal
+		}
 		return latest, err
 	}
 
 	rlog.Enter("rm.ReadOne")
+	endOperation = r.startOperation(ctx, "ReadOne")
 	observed, err := rm.ReadOne(ctx, latest)
+	endOperation(err)
 	rlog.Exit("rm.ReadOne", err)
 	if err != nil {
 		if err == ackerr.NotFound {
@@ -443,6 +1078,9 @@ func (r *resourceReconciler) createResource(
 			observed, err = r.delayedReadOneAfterCreate(ctx, rm, latest)
 			rlog.Exit("rm.delayedReadOneAfterCreate", err)
 			if err != nil {
+				if r.pollsAsyncCreate() {
+					return r.markCreateInProgress(ctx, desired, latest)
+				}
 				return latest, err
 			}
 		} else {
@@ -453,6 +1091,8 @@ func (r *resourceReconciler) createResource(
 	// Take the status from the latest ReadOne
 	latest.SetStatus(observed)
 
+	r.estimateCost(ctx, rm, latest)
+
 	// Ensure that we are patching any changes to the annotations/metadata and
 	// the Spec that may have been set by the resource manager's successful
 	// Create call above.
@@ -464,6 +1104,29 @@ func (r *resourceReconciler) createResource(
 	return latest, nil
 }
 
+// tagOnCreate returns true if the controller tags are added to resources
+// before they are created, according to the tagging model declared by the
+// resource descriptor.
+func (r *resourceReconciler) tagOnCreate() bool {
+	descriptor, ok := r.rd.(acktypes.AWSResourceTaggingModelDescriptor)
+	return !ok || descriptor.TaggingModel() != acktypes.TaggingModelTagAfterCreate
+}
+
+// tagAfterCreate applies the controller tags to the supplied newly-created
+// resource of a kind using the TaggingModelTagAfterCreate tagging model, by
+// updating the backend AWS resource with the tags added by EnsureTags.
+func (r *resourceReconciler) tagAfterCreate(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	latest acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	tagged := latest.DeepCopy()
+	if err := r.ensureTags(ctx, rm, tagged); err != nil {
+		return latest, err
+	}
+	return r.updateResource(ctx, rm, tagged, latest)
+}
+
 // delayedReadOneAfterCreate is a helper function called when a ReadOne call
 // fails with a 404 error right after a Create call. It uses a backoff/retry
 // mechanism to retrieve the observed state right after a readone call.
@@ -532,20 +1195,131 @@ func (r *resourceReconciler) updateResource(
 		return latest, err
 	}
 
+	// Give the user one reconciliation to confirm the state of newly adopted
+	// resources before enforcing their Spec, when configured to.
+	observeOnly, err := r.observeAdoptedResource(ctx, desired, latest)
+	if err != nil {
+		return latest, err
+	}
+
 	// Check to see if the latest observed state already matches the
 	// desired state and if not, update the resource
-	delta := r.rd.Delta(desired, latest)
+	delta := r.withoutServerDefaults(r.rd.Delta(desired, latest))
 	if delta.DifferentAt("Spec") {
+		if observeOnly {
+			Explain(ctx, explainStepUpdate, "adopted resource observed for the first time, not updating")
+			ackcondition.SetAdvisory(
+				latest, corev1.ConditionTrue,
+				&ackcondition.AdoptionObservedMessage,
+				&ackcondition.AdoptionObservedReason,
+			)
+			ackcondition.SetSynced(
+				latest, corev1.ConditionFalse,
+				&ackcondition.NotSyncedMessage, &ackcondition.AdoptionObservedReason,
+			)
+			rlog.Info(
+				"observed adopted resource, deferring update to the next reconciliation",
+				"diff", delta.Differences,
+			)
+			return latest, nil
+		}
 		rlog.Info(
 			"desired resource state has changed",
 			"diff", delta.Differences,
 		)
+		if r.isReadOnly(desired) {
+			Explain(ctx, explainStepUpdate, "Spec differs from the AWS resource, not updating in read-only mode")
+			r.reportReadOnlyDrift(ctx, latest, delta)
+			return latest, nil
+		}
+		if deferred, err := r.deferScheduledUpdate(ctx, desired, latest); deferred || err != nil {
+			Explain(ctx, explainStepUpdate, "not updating because of the %s annotation", ackv1alpha1.AnnotationApplyAfter)
+			return latest, err
+		}
+		destructiveFields := r.getDestructiveChanges(delta)
+		confirmed := isDestructiveUpdateConfirmed(desired)
+		clearedFields := delta.ClearedAt(r.protectedClearedFields...)
+		if len(clearedFields) > 0 && (!confirmed || r.cfg.ClearedFieldsPolicy == ackcfg.ClearedFieldsPolicyIgnore) {
+			reason := fmt.Sprintf(
+				"the update clears the protected fields %s. Set the %s "+
+					"annotation to \"true\" to apply it",
+				strings.Join(clearedFields, ", "),
+				ackv1alpha1.AnnotationConfirmDestructiveUpdate,
+			)
+			if r.cfg.ClearedFieldsPolicy == ackcfg.ClearedFieldsPolicyIgnore {
+				reason = fmt.Sprintf(
+					"the update clears the protected fields %s and is not applied",
+					strings.Join(clearedFields, ", "),
+				)
+			}
+			ackcondition.SetAdvisory(
+				latest, corev1.ConditionTrue,
+				&ackcondition.ProtectedFieldsClearedMessage, &reason,
+			)
+			ackcondition.SetSynced(
+				latest, corev1.ConditionFalse,
+				&ackcondition.NotSyncedMessage, &reason,
+			)
+			rlog.Info(
+				"not applying update clearing protected fields",
+				"fields", clearedFields,
+			)
+			Explain(ctx, explainStepUpdate, "not updating: %s", reason)
+			return latest, nil
+		}
+		if len(destructiveFields) > 0 && !confirmed {
+			reason := fmt.Sprintf(
+				"changes to %s require replacing the AWS resource. Set the "+
+					"%s annotation to \"true\" to apply them",
+				strings.Join(destructiveFields, ", "),
+				ackv1alpha1.AnnotationConfirmDestructiveUpdate,
+			)
+			ackcondition.SetAdvisory(
+				latest, corev1.ConditionTrue,
+				&ackcondition.DestructiveUpdateNotConfirmedMessage, &reason,
+			)
+			ackcondition.SetSynced(
+				latest, corev1.ConditionFalse,
+				&ackcondition.NotSyncedMessage, &reason,
+			)
+			rlog.Info(
+				"deferring destructive update until confirmed",
+				"fields", destructiveFields,
+			)
+			Explain(ctx, explainStepUpdate, "not updating: %s", reason)
+			return latest, nil
+		}
+		if err = r.failOnReadOnlyModeWrite(ctx, latest, operationUpdate); err != nil {
+			return latest, err
+		}
+		if err = r.checkPolicy(ctx, desired, latest, operationUpdate); err != nil {
+			return latest, err
+		}
+		Explain(ctx, explainStepUpdate, "Spec differs from the AWS resource at %s, updating", differentPaths(delta))
+		if err = r.waitRateLimit(ctx); err != nil {
+			return latest, err
+		}
+		observedBeforeUpdate := latest
 		rlog.Enter("rm.Update")
+		endOperation := r.startOperation(ctx, "Update")
 		latest, err = rm.Update(ctx, desired, latest, delta)
+		endOperation(err)
+		r.recordOperation(operationUpdate, err)
 		rlog.Exit("rm.Update", err, "latest", latest)
 		if err != nil {
+			if r.cfg.ReconcileReadAfterUpdateFailure {
+				latest = r.observePartialUpdate(ctx, rm, desired, observedBeforeUpdate, latest, err)
+			}
 			return latest, err
 		}
+		if len(destructiveFields) > 0 || len(clearedFields) > 0 {
+			// The confirmation only applies to the update that was just made.
+			// Remove it so that later destructive changes need confirming again.
+			annotations := latest.MetaObject().GetAnnotations()
+			delete(annotations, ackv1alpha1.AnnotationConfirmDestructiveUpdate)
+			latest.MetaObject().SetAnnotations(annotations)
+		}
+		r.estimateCost(ctx, rm, latest)
 		// Ensure that we are patching any changes to the annotations/metadata and
 		// the Spec that may have been set by the resource manager's successful
 		// Update call above.
@@ -554,10 +1328,156 @@ func (r *resourceReconciler) updateResource(
 			return latest, err
 		}
 		rlog.Info("updated resource")
+	} else {
+		Explain(ctx, explainStepUpdate, "Spec matches the AWS resource, not updating")
 	}
 	return latest, nil
 }
 
+// deferScheduledUpdate returns true if the update of the supplied resource
+// must be deferred because its services.k8s.aws/apply-after annotation is set
+// to a time in the future. In that case, an ACK.Advisory condition announcing
+// the scheduled update is set on the latest resource and the returned error requeues
+// the resource at the scheduled time.
+//
+// If the annotation is not a valid RFC3339 timestamp, an ACK.Terminal
+// condition is set and a Terminal error is returned.
+func (r *resourceReconciler) deferScheduledUpdate(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+) (bool, error) {
+	rlog := ackrtlog.FromContext(ctx)
+	applyAfter, err := GetApplyAfter(desired)
+	if err != nil {
+		reason := fmt.Sprintf(
+			"%s must be an RFC3339 timestamp: %v",
+			ackv1alpha1.AnnotationApplyAfter, err,
+		)
+		ackcondition.SetTerminal(
+			latest, corev1.ConditionTrue,
+			&ackcondition.InvalidApplyAfterMessage, &reason,
+		)
+		return true, ackerr.Terminal
+	}
+	if applyAfter == nil {
+		return false, nil
+	}
+	wait := time.Until(*applyAfter)
+	if wait <= 0 {
+		return false, nil
+	}
+	reason := fmt.Sprintf(
+		"update scheduled for %s", applyAfter.UTC().Format(time.RFC3339),
+	)
+	ackcondition.SetAdvisory(
+		latest, corev1.ConditionTrue,
+		&ackcondition.UpdateScheduledMessage, &reason,
+	)
+	ackcondition.SetSynced(
+		latest, corev1.ConditionFalse,
+		&ackcondition.NotSyncedMessage, &reason,
+	)
+	rlog.Info("deferring scheduled update", "apply_after", applyAfter)
+	return true, requeue.NeededAfter(nil, wait)
+}
+
+// withoutServerDefaults returns the supplied delta without the differences at
+// the fields declared as defaulted by the AWS service, see
+// AWSResourceServerDefaultedFieldDescriptor, that the desired resource leaves
+// unset.
+func (r *resourceReconciler) withoutServerDefaults(
+	delta *ackcompare.Delta,
+) *ackcompare.Delta {
+	descriptor, ok := r.rd.(acktypes.AWSResourceServerDefaultedFieldDescriptor)
+	if !ok {
+		return delta
+	}
+	return delta.WithoutDefaultedAt(descriptor.ServerDefaultedFields()...)
+}
+
+// getDestructiveChanges returns the paths of the fields declared destructive
+// by the resource descriptor that differ in the supplied delta.
+func (r *resourceReconciler) getDestructiveChanges(
+	delta *ackcompare.Delta,
+) []string {
+	descriptor, ok := r.rd.(acktypes.AWSResourceDestructiveFieldDescriptor)
+	if !ok {
+		return nil
+	}
+	changed := []string{}
+	for _, field := range descriptor.DestructiveFields() {
+		if delta.DifferentAt(field) {
+			changed = append(changed, field)
+		}
+	}
+	return changed
+}
+
+// isDestructiveUpdateConfirmed returns true if the supplied resource has the
+// `services.k8s.aws/confirm-destructive-update` annotation set to "true"
+func isDestructiveUpdateConfirmed(res acktypes.AWSResource) bool {
+	value, ok := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationConfirmDestructiveUpdate]
+	return ok && strings.ToLower(value) == "true"
+}
+
+// observePartialUpdate is called when rm.Update returns an error. Because an
+// Update may be made up of several AWS API calls, some of the desired changes
+// may have been applied to the backend AWS resource before the failure. This
+// method re-reads the AWS resource so that the CR's Status reflects what was
+// actually applied and, if the AWS resource still differs from the desired
+// state, sets an ACK.Advisory condition describing the partial application.
+//
+// If the re-read fails, the supplied failed resource is returned unchanged.
+func (r *resourceReconciler) observePartialUpdate(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	desired acktypes.AWSResource,
+	observedBeforeUpdate acktypes.AWSResource,
+	failed acktypes.AWSResource,
+	updateErr error,
+) acktypes.AWSResource {
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("r.observePartialUpdate")
+	defer func() {
+		exit(err)
+	}()
+
+	rlog.Enter("rm.ReadOne")
+	endOperation := r.startOperation(ctx, "ReadOne")
+	observed, err := rm.ReadOne(ctx, observedBeforeUpdate)
+	endOperation(err)
+	rlog.Exit("rm.ReadOne", err)
+	if err != nil || ackcompare.IsNil(observed) {
+		return failed
+	}
+
+	delta := r.withoutServerDefaults(r.rd.Delta(desired, observed))
+	if !delta.DifferentAt("Spec") {
+		return observed
+	}
+	unapplied := []string{}
+	for _, diff := range delta.Differences {
+		if diff.Path.Contains("Spec") {
+			unapplied = append(unapplied, diff.Path.String())
+		}
+	}
+	reason := fmt.Sprintf(
+		"update failed with error %q; fields not yet applied: %s",
+		updateErr.Error(), strings.Join(unapplied, ", "),
+	)
+	ackcondition.SetAdvisory(
+		observed, corev1.ConditionTrue,
+		&ackcondition.UpdatePartiallyAppliedMessage, &reason,
+	)
+	rlog.Info(
+		"update partially applied",
+		"unapplied", unapplied,
+	)
+	return observed
+}
+
 // lateInitializeResource calls AWSResourceManager.LateInitialize() method and
 // returns the AWSResource with late initialized fields.
 //
@@ -568,6 +1488,9 @@ func (r *resourceReconciler) updateResource(
 // late initialization attempts to correctly calculate exponential backoff delay
 //
 // This method also adds Condition to CR's status indicating status of late initialization.
+//
+// A NotImplemented error returned by LateInitialize is ignored and the
+// supplied AWSResource is returned unchanged.
 func (r *resourceReconciler) lateInitializeResource(
 	ctx context.Context,
 	rm acktypes.AWSResourceManager,
@@ -581,8 +1504,18 @@ func (r *resourceReconciler) lateInitializeResource(
 	}()
 
 	rlog.Enter("rm.LateInitialize")
+	endOperation := r.startOperation(ctx, "LateInitialize")
 	lateInitializedLatest, err := rm.LateInitialize(ctx, latest)
+	endOperation(err)
 	rlog.Exit("rm.LateInitialize", err)
+	if errors.Is(err, ackerr.NotImplemented) {
+		// The resource manager of this kind does not late initialize
+		// resources yet. Skip the phase rather than failing the
+		// reconciliation.
+		rlog.Debug("late initialization not implemented, skipping")
+		err = nil
+		return latest, nil
+	}
 	// Always patch after late initialize because some fields may have been initialized while
 	// others require a retry after some delay.
 	// This patching does not hurt because if there is no diff then 'patchResourceMetadataAndSpec'
@@ -661,8 +1594,14 @@ func (r *resourceReconciler) patchResourceMetadataAndSpec(
 	rlog.Enter("kc.Patch (metadata + spec)")
 	dobj := desired.DeepCopy().RuntimeObject()
 	lorig := latest.DeepCopy()
-	patch := client.MergeFrom(dobj)
-	err = r.kc.Patch(ctx, latest.RuntimeObject(), patch)
+	patch, err := r.patchWithConflictRetry(
+		ctx, dobj, latest.RuntimeObject(),
+		func(patch client.Patch) error {
+			patchStart := time.Now()
+			defer func() { r.observePatchLatency(time.Since(patchStart)) }()
+			return r.kc.Patch(ctx, latest.RuntimeObject(), patch)
+		},
+	)
 	if err == nil {
 		if rlog.IsDebugEnabled() {
 			js := getPatchDocument(patch, lorig.RuntimeObject())
@@ -697,8 +1636,23 @@ func (r *resourceReconciler) patchResourceStatus(
 	rlog.Enter("kc.Patch (status)")
 	dobj := desired.DeepCopy().RuntimeObject()
 	lobj := latest.DeepCopy().RuntimeObject()
-	patch := client.MergeFrom(dobj)
-	err = r.kc.Status().Patch(ctx, lobj, patch)
+	if len(r.encryptedStatusFields) > 0 {
+		// Never write the protected fields in plaintext
+		if err = EncryptStatusFields(
+			r.statusEncryptionKey, r.encryptedStatusFields, dobj, lobj,
+		); err != nil {
+			rlog.Exit("kc.Patch (status)", err)
+			return err
+		}
+	}
+	patch, err := r.patchWithConflictRetry(
+		ctx, dobj, lobj,
+		func(patch client.Patch) error {
+			patchStart := time.Now()
+			defer func() { r.observePatchLatency(time.Since(patchStart)) }()
+			return r.kc.Status().Patch(ctx, lobj, patch)
+		},
+	)
 	if err == nil {
 		if rlog.IsDebugEnabled() {
 			js := getPatchDocument(patch, lobj)
@@ -733,8 +1687,13 @@ func (r *resourceReconciler) deleteResource(
 		exit(err)
 	}()
 
+	if err = r.waitRateLimit(ctx); err != nil {
+		return current, err
+	}
 	rlog.Enter("rm.ReadOne")
+	endOperation := r.startOperation(ctx, "ReadOne")
 	observed, err := rm.ReadOne(ctx, current)
+	endOperation(err)
 	rlog.Exit("rm.ReadOne", err)
 	if err != nil {
 		if err == ackerr.NotFound {
@@ -743,8 +1702,23 @@ func (r *resourceReconciler) deleteResource(
 		}
 		return current, err
 	}
+	if !r.isManaged(current) {
+		// The AWS resource still exists but something other than ACK removed
+		// the finalizer. We still delete the AWS resource below, but the
+		// resource may disappear from under us at any moment.
+		r.handleOutOfBandFinalizerRemoval(ctx, observed)
+	}
+	if err = r.failOnReadOnlyModeWrite(ctx, observed, operationDelete); err != nil {
+		return observed, err
+	}
+	if err = r.waitRateLimit(ctx); err != nil {
+		return observed, err
+	}
 	rlog.Enter("rm.Delete")
+	endOperation = r.startOperation(ctx, "Delete")
 	latest, err := rm.Delete(ctx, observed)
+	endOperation(err)
+	r.recordOperation(operationDelete, err)
 	rlog.Exit("rm.Delete", err)
 	if ackcompare.IsNotNil(latest) {
 		// The Delete operation may be asynchronous and the resource manager
@@ -778,6 +1752,62 @@ func (r *resourceReconciler) deleteResource(
 	return latest, err
 }
 
+// ensureTags calls the resource manager's EnsureTags method on the supplied
+// resource. A NotImplemented error returned by EnsureTags is ignored.
+//
+// When the --tolerate-tag-failures flag is set, a failure to apply the tags
+// does not fail the reconciliation: a warning is logged, an ACK.TagsApplied
+// condition with a False status is set on the resource and nil is returned.
+//
+// EnsureTags is not called for resources reconciled in read-only mode, whose
+// tags are reported as they are observed rather than enforced.
+func (r *resourceReconciler) ensureTags(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	res acktypes.AWSResource,
+) error {
+	rlog := ackrtlog.FromContext(ctx)
+	if r.isReadOnly(res) {
+		rlog.Debug("read-only mode, skipping tagging")
+		return nil
+	}
+	rlog.Enter("rm.EnsureTags")
+	endOperation := r.startOperation(ctx, "EnsureTags")
+	err := rm.EnsureTags(ctx, res, r.sc.GetMetadata())
+	endOperation(err)
+	rlog.Exit("rm.EnsureTags", err)
+	if errors.Is(err, ackerr.NotImplemented) {
+		rlog.Debug("tagging not implemented, skipping")
+		return nil
+	}
+	if err == nil || !r.cfg.TolerateTagFailures {
+		return err
+	}
+	rlog.Info("WARNING: failed to apply tags, continuing reconciliation", "error", err.Error())
+	reason := err.Error()
+	ackcondition.SetTagsApplied(
+		res, corev1.ConditionFalse, &ackcondition.TagsNotAppliedMessage, &reason,
+	)
+	return nil
+}
+
+// propagateTagsApplied copies the ACK.TagsApplied condition set by ensureTags
+// on the desired resource to the latest resource, in case the resource manager
+// did not carry the desired resource's conditions over.
+func (r *resourceReconciler) propagateTagsApplied(
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+) {
+	if !r.cfg.TolerateTagFailures || ackcompare.IsNil(desired) || ackcompare.IsNil(latest) {
+		return
+	}
+	c := ackcondition.TagsApplied(desired)
+	if c == nil || ackcondition.TagsApplied(latest) != nil {
+		return
+	}
+	ackcondition.SetTagsApplied(latest, c.Status, c.Message, c.Reason)
+}
+
 // setResourceManaged marks the underlying CR in the supplied AWSResource with
 // a finalizer that indicates the object is under ACK management and will not
 // be deleted until that finalizer is removed (in setResourceUnmanaged())
@@ -785,7 +1815,7 @@ func (r *resourceReconciler) setResourceManaged(
 	ctx context.Context,
 	res acktypes.AWSResource,
 ) error {
-	if r.rd.IsManaged(res) {
+	if r.isManaged(res) {
 		return nil
 	}
 	var err error
@@ -796,7 +1826,7 @@ func (r *resourceReconciler) setResourceManaged(
 	}()
 
 	orig := res.DeepCopy().RuntimeObject()
-	r.rd.MarkManaged(res)
+	markManaged(r.rd, r.cfg.FinalizerOverride, res)
 	err = r.patchResourceMetadataAndSpec(ctx, r.rd.ResourceFromRuntimeObject(orig), res)
 	if err != nil {
 		return err
@@ -812,7 +1842,7 @@ func (r *resourceReconciler) setResourceUnmanaged(
 	ctx context.Context,
 	res acktypes.AWSResource,
 ) error {
-	if !r.rd.IsManaged(res) {
+	if !r.isManaged(res) {
 		return nil
 	}
 
@@ -824,11 +1854,12 @@ func (r *resourceReconciler) setResourceUnmanaged(
 	}()
 
 	orig := res.DeepCopy().RuntimeObject()
-	r.rd.MarkUnmanaged(res)
+	markUnmanaged(r.rd, r.cfg.FinalizerOverride, res)
 	err = r.patchResourceMetadataAndSpec(ctx, r.rd.ResourceFromRuntimeObject(orig), res)
 	if err != nil {
 		return err
 	}
+	r.releaseCreateSlot(res)
 	rlog.Debug("removed resource from management")
 	return nil
 }
@@ -840,7 +1871,7 @@ func (r *resourceReconciler) failOnResourceUnmanaged(
 	ctx context.Context,
 	res acktypes.AWSResource,
 ) error {
-	if r.rd.IsManaged(res) {
+	if r.isManaged(res) {
 		return nil
 	}
 
@@ -848,6 +1879,128 @@ func (r *resourceReconciler) failOnResourceUnmanaged(
 	return ackerr.Terminal
 }
 
+// failOnMissingRequiredAnnotations ensures that the supplied resource carries
+// all the annotations configured with the --required-annotations flag. If it
+// does not and --enforce-required-annotations is set, it sets a Terminal
+// condition naming the missing annotations and returns a Terminal error, so
+// that no AWS API is called for the resource.
+func (r *resourceReconciler) failOnMissingRequiredAnnotations(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) error {
+	if len(r.cfg.RequiredAnnotations) == 0 {
+		return nil
+	}
+	missing := MissingRequiredAnnotations(res, r.cfg.RequiredAnnotations)
+	if len(missing) == 0 {
+		return nil
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	if !r.cfg.EnforceRequiredAnnotations {
+		rlog.Info("resource is missing required annotations", "missing", missing)
+		return nil
+	}
+	msg := ackerr.MissingRequiredAnnotationFor(missing...).Error()
+	ackcondition.SetTerminal(res, corev1.ConditionTrue, &msg, nil)
+	return ackerr.Terminal
+}
+
+// failOnNamingPolicyViolation sets an ACK.Terminal condition on the supplied
+// resource and returns a Terminal error if its name does not match the
+// pattern configured for its kind with --resource-name-patterns. Only
+// resources implementing AWSResourceWithSpecName and whose name is set are
+// checked.
+func (r *resourceReconciler) failOnNamingPolicyViolation(
+	res acktypes.AWSResource,
+) error {
+	if r.namePattern == nil {
+		return nil
+	}
+	named, ok := res.(acktypes.AWSResourceWithSpecName)
+	if !ok {
+		return nil
+	}
+	name := named.SpecName()
+	if name == "" || r.namePattern.MatchString(name) {
+		return nil
+	}
+	msg := ackerr.NamingPolicyViolationFor(name, r.namePattern.String()).Error()
+	ackcondition.SetTerminal(res, corev1.ConditionTrue, &msg, nil)
+	return ackerr.Terminal
+}
+
+// checkPolicy asks the policy endpoint configured with --policy-endpoint-url
+// whether the supplied operation may be performed to reach the supplied
+// desired state.
+//
+// When the operation is denied, an ACK.Terminal condition containing the
+// policy's reason is set on the supplied subject, the resource that is
+// patched back to the Kubernetes API, and a Terminal error is returned.
+// When the policy endpoint cannot be reached, the operation is allowed if
+// --policy-fail-open is set; otherwise an error is returned so that the
+// reconciliation is retried.
+func (r *resourceReconciler) checkPolicy(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	subject acktypes.AWSResource,
+	operation string,
+) error {
+	if r.policyChecker == nil {
+		return nil
+	}
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("r.checkPolicy")
+	defer func() {
+		exit(err)
+	}()
+
+	decision, err := r.policyChecker.Check(ctx, ackpolicy.Input{
+		Operation: operation,
+		Kind:      r.rd.GroupKind().Kind,
+		Resource:  desired.RuntimeObject(),
+	})
+	if err != nil {
+		if r.cfg.PolicyFailOpen {
+			rlog.Info("policy check failed, allowing operation", "error", err.Error())
+			return nil
+		}
+		return fmt.Errorf("policy check failed: %v", err)
+	}
+	if decision.Allow {
+		return nil
+	}
+	reason := decision.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("%s denied by policy", operation)
+	}
+	rlog.Info("operation denied by policy", "operation", operation, "reason", reason)
+	ackcondition.SetTerminal(
+		subject, corev1.ConditionTrue, &ackcondition.PolicyDeniedMessage, &reason,
+	)
+	return ackerr.Terminal
+}
+
+// matchesCanaryAnnotation returns true if the supplied resource should be
+// reconciled by this controller based on the --canary-annotation-key and
+// --canary-annotation-value configuration.
+//
+// When no canary annotation key is configured, every resource matches. When
+// a key is configured without a value, any resource having the annotation
+// matches, regardless of the annotation's value.
+func (r *resourceReconciler) matchesCanaryAnnotation(
+	res acktypes.AWSResource,
+) bool {
+	if r.cfg.CanaryAnnotationKey == "" {
+		return true
+	}
+	value, ok := res.MetaObject().GetAnnotations()[r.cfg.CanaryAnnotationKey]
+	if !ok {
+		return false
+	}
+	return r.cfg.CanaryAnnotationValue == "" || value == r.cfg.CanaryAnnotationValue
+}
+
 // getAWSResource returns an AWSResource representing the requested Kubernetes
 // namespaced object
 // NOTE: this method makes direct call to k8s apiserver. Currently this method
@@ -881,26 +2034,66 @@ func (r *resourceReconciler) handleRequeues(
 	ctx context.Context,
 	latest acktypes.AWSResource,
 ) (acktypes.AWSResource, error) {
-	if ackcompare.IsNotNil(latest) {
-		rlog := ackrtlog.FromContext(ctx)
-		for _, condition := range latest.Conditions() {
-			if condition.Type != ackv1alpha1.ConditionTypeResourceSynced {
-				continue
-			}
-			// The code below only executes for "ConditionTypeResourceSynced"
-			if condition.Status == corev1.ConditionTrue {
-				rlog.Debug("requeuing", "after", r.resyncPeriod)
-				return latest, requeue.NeededAfter(nil, r.resyncPeriod)
-			} else {
-				rlog.Debug(
-					"requeueing resource after finding resource synced condition false",
-				)
-				return latest, requeue.NeededAfter(
-					ackerr.TemporaryOutOfSync, requeue.DefaultRequeueAfterDuration)
-			}
+	if ackcompare.IsNil(latest) {
+		return latest, nil
+	}
+	rlog := ackrtlog.FromContext(ctx)
+	err := RequeueForConditions(
+		latest.Conditions(),
+		JitterResyncPeriod(r.getResourceResyncPeriod(latest), r.cfg.ResyncJitterFactor, rand.Float64()),
+	)
+	var requeueNeededAfter *requeue.RequeueNeededAfter
+	if errors.As(err, &requeueNeededAfter) {
+		if requeueNeededAfter.Unwrap() == nil {
+			rlog.Debug("requeuing", "after", requeueNeededAfter.Duration())
+		} else {
+			rlog.Debug(
+				"requeueing resource after finding resource synced condition false",
+			)
 		}
 	}
-	return latest, nil
+	return latest, err
+}
+
+// getResourceResyncPeriod returns the period after which the supplied
+// resource should be resynced. If the resource manager factory implements
+// acktypes.ResyncPeriodResolver, the resolver decides the period for the
+// resource; otherwise the reconciler's static resync period is returned.
+//
+// Resources referring to kinds that are not watched are resynced after
+// --unwatched-reference-resync-seconds at the latest, so that changes to the
+// resources they reference are eventually picked up.
+func (r *resourceReconciler) getResourceResyncPeriod(
+	res acktypes.AWSResource,
+) time.Duration {
+	period := r.resyncPeriod
+	if r.resyncResolver != nil {
+		if resolved := r.resyncResolver.ResolveResyncPeriod(res, r.resyncPeriod); resolved > 0 {
+			period = resolved
+		}
+	}
+	unwatchedPeriod := time.Duration(r.cfg.UnwatchedReferenceResyncSeconds) * time.Second
+	if unwatchedPeriod > 0 && unwatchedPeriod < period && r.refersToUnwatchedKind(res) {
+		return unwatchedPeriod
+	}
+	return period
+}
+
+// refersToUnwatchedKind returns true if the supplied resource refers to a
+// resource whose kind is excluded from --reference-watch-kinds.
+func (r *resourceReconciler) refersToUnwatchedKind(
+	res acktypes.AWSResource,
+) bool {
+	refDescriptor, ok := r.rd.(acktypes.AWSResourceReferenceDescriptor)
+	if !ok {
+		return false
+	}
+	for _, ref := range refDescriptor.ReferencedResources(res) {
+		if !r.cfg.ReferenceWatchEnabled(ref.GroupKind.Kind) {
+			return true
+		}
+	}
+	return false
 }
 
 // HandleReconcileError will handle errors from reconcile handlers, which
@@ -930,36 +2123,95 @@ func (r *resourceReconciler) HandleReconcileError(
 		// there is a more robust way to handle failures in the patch operation
 		_ = r.patchResourceStatus(ctx, desired, latest)
 	}
+	result, resultErr := ResultForError(err)
+	if resultErr != nil || (!result.Requeue && result.RequeueAfter == 0) {
+		return result, resultErr
+	}
+	result.RequeueAfter = r.applyBackpressure(ctx, result.RequeueAfter)
+
+	rlog := ackrtlog.FromContext(ctx)
+	var wrappedError error
+	var requeueNeededAfter *requeue.RequeueNeededAfter
+	var requeueNeeded *requeue.RequeueNeeded
+	if errors.As(err, &requeueNeededAfter) {
+		wrappedError = requeueNeededAfter.Unwrap()
+	} else if errors.As(err, &requeueNeeded) {
+		wrappedError = requeueNeeded.Unwrap()
+	}
+	if wrappedError != nil {
+		rlog.Debug(
+			"requeue needed after error",
+			"error", wrappedError,
+			"after", result.RequeueAfter,
+		)
+	} else if result.RequeueAfter > 0 {
+		rlog.Debug("requeueing", "after", result.RequeueAfter)
+	} else {
+		rlog.Debug("requeueing immediately")
+	}
+	return result, nil
+}
+
+// JitterResyncPeriod returns the supplied resync period offset by up to the
+// supplied factor of the period, e.g. by up to ±10% for a factor of 0.1. The
+// offset is proportional to the supplied random number, between 0 and 1,
+// which maps to the largest negative and positive offsets respectively.
+func JitterResyncPeriod(
+	period time.Duration,
+	factor float64,
+	random float64,
+) time.Duration {
+	if factor <= 0 || period <= 0 {
+		return period
+	}
+	return period + time.Duration((2*random-1)*factor*float64(period))
+}
+
+// RequeueForConditions returns the requeue error that should be returned
+// from a reconciliation loop for a resource having the supplied conditions.
+//
+// If the resource has an ACK.ResourceSynced condition with a True status, the
+// resource is requeued after the supplied resync period. If the condition's
+// status is anything else, the resource is requeued after
+// requeue.DefaultRequeueAfterDuration. If the resource has no
+// ACK.ResourceSynced condition, nil is returned and the resource is not
+// requeued.
+func RequeueForConditions(
+	conditions []*ackv1alpha1.Condition,
+	resyncPeriod time.Duration,
+) error {
+	for _, condition := range conditions {
+		if condition.Type != ackv1alpha1.ConditionTypeResourceSynced {
+			continue
+		}
+		if condition.Status == corev1.ConditionTrue {
+			return requeue.NeededAfter(nil, resyncPeriod)
+		}
+		return requeue.NeededAfter(
+			ackerr.TemporaryOutOfSync, requeue.DefaultRequeueAfterDuration)
+	}
+	return nil
+}
+
+// ResultForError returns the controller-runtime Result and error that a
+// reconciler should return for the supplied reconciliation error.
+//
+// Nil and Terminal errors result in no requeue. RequeueNeededAfter and
+// RequeueNeeded errors result in a requeue after the requested duration, or
+// immediately, without returning an error. Any other error is returned as-is
+// so that controller-runtime logs it and requeues with its rate limiter.
+func ResultForError(err error) (ctrlrt.Result, error) {
 	if err == nil || err == ackerr.Terminal {
 		return ctrlrt.Result{}, nil
 	}
-	rlog := ackrtlog.FromContext(ctx)
 
 	var requeueNeededAfter *requeue.RequeueNeededAfter
 	if errors.As(err, &requeueNeededAfter) {
-		after := requeueNeededAfter.Duration()
-		if wrappedError := requeueNeededAfter.Unwrap(); wrappedError != nil {
-			rlog.Debug(
-				"requeue needed after error",
-				"error", wrappedError,
-				"after", after,
-			)
-		} else {
-			rlog.Debug("requeueing", "after", after)
-		}
-		return ctrlrt.Result{RequeueAfter: after}, nil
+		return ctrlrt.Result{RequeueAfter: requeueNeededAfter.Duration()}, nil
 	}
 
 	var requeueNeeded *requeue.RequeueNeeded
 	if errors.As(err, &requeueNeeded) {
-		if wrappedError := requeueNeeded.Unwrap(); wrappedError != nil {
-			rlog.Debug(
-				"requeue needed after error",
-				"error", wrappedError,
-			)
-		} else {
-			rlog.Debug("requeueing immediately")
-		}
 		return ctrlrt.Result{Requeue: true}, nil
 	}
 
@@ -974,20 +2226,65 @@ func (r *resourceReconciler) HandleReconcileError(
 func (r *resourceReconciler) getOwnerAccountID(
 	res acktypes.AWSResource,
 ) ackv1alpha1.AWSAccountID {
+	acctID, _ := r.resolveOwnerAccountID(res)
+	return acctID
+}
+
+// resolveOwnerAccountID returns the account ID returned by getOwnerAccountID
+// along with a description of where it was found.
+func (r *resourceReconciler) resolveOwnerAccountID(
+	res acktypes.AWSResource,
+) (ackv1alpha1.AWSAccountID, string) {
 	acctID := res.Identifiers().OwnerAccountID()
 	if acctID != nil {
-		return *acctID
+		return *acctID, "resource status"
 	}
 
 	// look for owner account id in the namespace annotations
 	namespace := res.MetaObject().GetNamespace()
 	accID, ok := r.cache.Namespaces.GetOwnerAccountID(namespace)
 	if ok {
-		return ackv1alpha1.AWSAccountID(accID)
+		return ackv1alpha1.AWSAccountID(accID), "namespace annotation"
 	}
 
 	// use controller configuration
-	return ackv1alpha1.AWSAccountID(r.cfg.AccountID)
+	return ackv1alpha1.AWSAccountID(r.cfg.AccountID), "controller configuration"
+}
+
+// failOnUnresolvedAccount sets an ACK.Terminal condition on a resource for
+// which no AWS account could be resolved, instead of attempting AWS
+// operations with an empty account. The resource is reconciled again after
+// --unresolved-account-requeue-seconds, if set, so that an account configured
+// later for its namespace is picked up.
+func (r *resourceReconciler) failOnUnresolvedAccount(
+	ctx context.Context,
+	req ctrlrt.Request,
+	desired acktypes.AWSResource,
+) (ctrlrt.Result, error) {
+	r.log.Info(
+		ackerr.UnresolvedAccount.Error(),
+		"kind", r.rd.GroupKind().Kind,
+		"namespace", req.Namespace,
+		"name", req.Name,
+	)
+	latest := desired.DeepCopy()
+	ackcondition.SetTerminal(
+		latest, corev1.ConditionTrue,
+		&ackcondition.UnresolvedAccountMessage,
+		&ackcondition.UnresolvedAccountReason,
+	)
+	ackcondition.SetSynced(
+		latest, corev1.ConditionFalse, &ackcondition.NotSyncedMessage, nil,
+	)
+	if err := r.patchResourceStatus(ctx, desired, latest); err != nil {
+		return ctrlrt.Result{}, err
+	}
+	if r.cfg.UnresolvedAccountRequeueSeconds > 0 {
+		return ctrlrt.Result{
+			RequeueAfter: time.Duration(r.cfg.UnresolvedAccountRequeueSeconds) * time.Second,
+		}, nil
+	}
+	return ctrlrt.Result{}, nil
 }
 
 // getRoleARN return the Role ARN that should be assumed in order to manage
@@ -1010,28 +2307,100 @@ func (r *resourceReconciler) getRoleARN(
 func (r *resourceReconciler) getRegion(
 	res acktypes.AWSResource,
 ) ackv1alpha1.AWSRegion {
+	region, _ := r.resolveRegion(res)
+	return region
+}
+
+// resolveRegion returns the region returned by getRegion along with a
+// description of where it was found.
+func (r *resourceReconciler) resolveRegion(
+	res acktypes.AWSResource,
+) (ackv1alpha1.AWSRegion, string) {
 	// first try to get the region from the status.resourceMetadata
 	metadataRegion := res.Identifiers().Region()
 	if metadataRegion != nil {
-		return *metadataRegion
+		return *metadataRegion, "resource status"
 	}
 
 	// look for region in CR metadata annotations
 	resAnnotations := res.MetaObject().GetAnnotations()
 	region, ok := resAnnotations[ackv1alpha1.AnnotationRegion]
 	if ok {
-		return ackv1alpha1.AWSRegion(region)
+		return ackv1alpha1.AWSRegion(region), "resource annotation"
 	}
 
 	// look for default region in namespace metadata annotations
 	ns := res.MetaObject().GetNamespace()
 	defaultRegion, ok := r.cache.Namespaces.GetDefaultRegion(ns)
 	if ok {
-		return ackv1alpha1.AWSRegion(defaultRegion)
+		return ackv1alpha1.AWSRegion(defaultRegion), "namespace annotation"
 	}
 
 	// use controller configuration region
-	return ackv1alpha1.AWSRegion(r.cfg.Region)
+	return ackv1alpha1.AWSRegion(r.cfg.Region), "controller configuration"
+}
+
+// regionConflict returns the region of the services.k8s.aws/region annotation
+// of the supplied resource, and true, if it differs from the region recorded
+// in the resource's Status when its AWS resource was created. Changing the
+// annotation would otherwise silently point the controller at a region
+// where the AWS resource does not live.
+//
+// Conflicts are ignored for resources being deleted, which are deleted from
+// the region they were created in, and when --ignore-region-conflicts is set.
+func (r *resourceReconciler) regionConflict(
+	res acktypes.AWSResource,
+) (ackv1alpha1.AWSRegion, bool) {
+	if r.cfg.IgnoreRegionConflicts || !res.MetaObject().GetDeletionTimestamp().IsZero() {
+		return "", false
+	}
+	created := res.Identifiers().Region()
+	annotated, ok := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationRegion]
+	if created == nil || !ok || annotated == "" {
+		return "", false
+	}
+	if ackv1alpha1.AWSRegion(annotated) == *created {
+		return "", false
+	}
+	return ackv1alpha1.AWSRegion(annotated), true
+}
+
+// failOnRegionConflict sets an ACK.Terminal condition on a resource whose
+// services.k8s.aws/region annotation conflicts with the region its AWS
+// resource was created in. Annotation changes do not trigger
+// reconciliations, so the resource is requeued to notice when the annotation
+// is restored.
+func (r *resourceReconciler) failOnRegionConflict(
+	ctx context.Context,
+	req ctrlrt.Request,
+	desired acktypes.AWSResource,
+	created ackv1alpha1.AWSRegion,
+	annotated ackv1alpha1.AWSRegion,
+) (ctrlrt.Result, error) {
+	r.log.Info(
+		"region annotation conflicts with the region of the resource",
+		"kind", r.rd.GroupKind().Kind,
+		"namespace", req.Namespace,
+		"name", req.Name,
+		"region", created,
+		"annotated_region", annotated,
+	)
+	reason := fmt.Sprintf(
+		"the AWS resource was created in %s but the %s annotation is set "+
+			"to %s. Restore the annotation to reconcile the resource",
+		created, ackv1alpha1.AnnotationRegion, annotated,
+	)
+	latest := desired.DeepCopy()
+	ackcondition.SetTerminal(
+		latest, corev1.ConditionTrue, &ackcondition.RegionConflictMessage, &reason,
+	)
+	ackcondition.SetSynced(
+		latest, corev1.ConditionFalse, &ackcondition.NotSyncedMessage, &reason,
+	)
+	if err := r.patchResourceStatus(ctx, desired, latest); err != nil {
+		return ctrlrt.Result{}, err
+	}
+	return ctrlrt.Result{RequeueAfter: requeue.DefaultRequeueAfterDuration}, nil
 }
 
 // getDeletionPolicy returns the resource's deletion policy based on the default
@@ -1087,17 +2456,20 @@ func (r *resourceReconciler) getEndpointURL(
 // It attempts to retrieve the duration from the following sources, in this order:
 //  1. A resource-specific reconciliation resync period specified in the reconciliation resync
 //     configuration map (--reconcile-default-resync-seconds).
-//  2. A resource-specific requeue on success period specified by the resource manager factory.
+//  2. A resource-specific requeue on success period specified in the requeue on success
+//     overrides configuration map (--requeue-on-success-seconds).
+//  3. A resource-specific requeue on success period specified by the resource manager factory.
 //     The resource manager factory is controller-specific, and thus this period is to specified
 //     by controller authors (using ack-generate).
-//  3. The default reconciliation resync period period specified in the controller binary flags.
+//  4. The default reconciliation resync period period specified in the controller binary flags.
 //     (--reconcile-resource-resync-seconds)
-//  4. The default resync period defined in the ACK runtime package. Defined in defaultResyncPeriod
+//  5. The default resync period defined in the ACK runtime package. Defined in defaultResyncPeriod
 //     within the same file
 //
 // Each reconciler has a unique value to use. This function should only be called during the
 // instantiation of an AWSResourceReconciler and should not be called during the reconciliation
-// function r.Sync
+// function r.Sync. Resource manager factories implementing acktypes.ResyncPeriodResolver may
+// further refine the returned value for each resource (see getResourceResyncPeriod).
 func getResyncPeriod(rmf acktypes.AWSResourceManagerFactory, cfg ackcfg.Config) time.Duration {
 	// The reconciliation resync period configuration has already been validated as
 	// a clean map. Therefore, we can safely ignore any errors that may occur while
@@ -1111,7 +2483,15 @@ func getResyncPeriod(rmf acktypes.AWSResourceManagerFactory, cfg ackcfg.Config)
 		return time.Duration(duration) * time.Second
 	}
 
-	// Second, try to use a resource-specific requeue on success period specified by the
+	// Second, try to use a resource-specific requeue on success period overridden by the
+	// operator. It takes precedence over the period built into the resource manager
+	// factory so that it can be adjusted without rebuilding the controller.
+	overrides, _ := cfg.ParseRequeueOnSuccessOverrides()
+	if duration, ok := overrides[strings.ToLower(resourceKind)]; ok && duration > 0 {
+		return duration
+	}
+
+	// Third, try to use a resource-specific requeue on success period specified by the
 	// resource manager factory. This value is set during the code generation of the
 	// controller and takes precedence over the default resync period period because
 	// it allows existing controllers that rely on this value to maintain their intended
@@ -1120,7 +2500,7 @@ func getResyncPeriod(rmf acktypes.AWSResourceManagerFactory, cfg ackcfg.Config)
 		return time.Duration(duration) * time.Second
 	}
 
-	// Third, try to use the default resync period resync period specified during controller
+	// Fourth, try to use the default resync period resync period specified during controller
 	// start-up.
 	if cfg.ReconcileDefaultResyncSeconds > 0 {
 		return time.Duration(cfg.ReconcileDefaultResyncSeconds) * time.Second
@@ -1161,6 +2541,55 @@ func NewReconcilerWithClient(
 		"reconciler kind", rmf.ResourceDescriptor().GroupKind().Kind,
 		"resync period seconds", resyncPeriod.Seconds(),
 	)
+	resyncResolver, _ := rmf.(acktypes.ResyncPeriodResolver)
+	// Invalid severities are reported by cfg.ValidateReconcileConfig
+	errorSeverities, _ := cfg.ParseAWSErrorSeverities()
+	loggerFields, _ := cfg.ParseResourceLoggerFields()
+	protectedClearedFields, _ := cfg.ParseProtectedClearedFields()
+	namePatterns, _ := cfg.ParseResourceNamePatterns()
+	configuredRequiredFields, _ := cfg.ParseRequiredFieldValues()
+	var createSlots *createSlotTracker
+	createBudgets, _ := cfg.ParseCreateBudgets()
+	if budget, ok := createBudgets[strings.ToLower(
+		rmf.ResourceDescriptor().GroupKind().Kind,
+	)]; ok {
+		createSlots = newCreateSlotTracker(budget)
+	}
+	encryptedStatusFields, _ := cfg.ParseEncryptedStatusFields()
+	var statusEncryptionKey []byte
+	if len(cfg.EncryptedStatusFields) > 0 {
+		statusEncryptionKey, _ = cfg.LoadStatusEncryptionKey()
+	}
+	var policyChecker *ackpolicy.Checker
+	if cfg.PolicyEndpointURL != "" {
+		policyChecker = ackpolicy.NewChecker(
+			cfg.PolicyEndpointURL,
+			time.Duration(cfg.PolicyTimeoutSeconds)*time.Second,
+		)
+	}
+	var sessions *sessionCache
+	if cfg.SessionCacheTTLSeconds > 0 {
+		sessions = newSessionCache(time.Duration(cfg.SessionCacheTTLSeconds) * time.Second)
+	}
+	var accessDenied *accessDeniedDetector
+	if cfg.AccessDeniedThreshold > 0 {
+		accessDenied = newAccessDeniedDetector(
+			cfg.AccessDeniedThreshold,
+			time.Duration(cfg.AccessDeniedWindowSeconds)*time.Second,
+			time.Duration(cfg.AccessDeniedPauseSeconds)*time.Second,
+		)
+	}
+	var rateLimiter *rate.Limiter
+	rateLimits, _ := cfg.ParseResourceRateLimits()
+	if rps := rateLimits[strings.ToLower(
+		rmf.ResourceDescriptor().GroupKind().Kind,
+	)]; rps > 0 {
+		rateLimiter = rate.NewLimiter(rate.Limit(rps), rps)
+	}
+	var transitions *conditionTransitionLog
+	if cfg.ConditionTransitionLogSize > 0 {
+		transitions = newConditionTransitionLog(cfg.ConditionTransitionLogSize)
+	}
 	return &resourceReconciler{
 		reconciler: reconciler{
 			sc:      sc,
@@ -1170,8 +2599,36 @@ func NewReconcilerWithClient(
 			metrics: metrics,
 			cache:   cache,
 		},
-		rmf:          rmf,
-		rd:           rmf.ResourceDescriptor(),
-		resyncPeriod: resyncPeriod,
+		rmf:            rmf,
+		rd:             rmf.ResourceDescriptor(),
+		resyncPeriod:   resyncPeriod,
+		resyncResolver: resyncResolver,
+		patchLatency: &patchLatencyTracker{
+			threshold: time.Duration(cfg.BackpressurePatchLatencyMs) * time.Millisecond,
+		},
+		errorSeverities:  errorSeverities,
+		references:       newReferenceIndex(),
+		secretReferences: newReferenceIndex(),
+		resyncs:          newResyncTracker(),
+		forcedReconciles: newForcedReconcileTracker(),
+		policyChecker:    policyChecker,
+		loggerFields:     loggerFields,
+		sessions:         sessions,
+		createSlots:      createSlots,
+		requiredFields:   requiredFieldValues(rmf.ResourceDescriptor(), configuredRequiredFields),
+		costs:            newCostTracker(),
+		accessDenied:     accessDenied,
+		rateLimiter:      rateLimiter,
+		encryptedStatusFields: encryptedStatusFields[strings.ToLower(
+			rmf.ResourceDescriptor().GroupKind().Kind,
+		)],
+		statusEncryptionKey: statusEncryptionKey,
+		transitions:         transitions,
+		protectedClearedFields: protectedClearedFields[strings.ToLower(
+			rmf.ResourceDescriptor().GroupKind().Kind,
+		)],
+		namePattern: namePatterns[strings.ToLower(
+			rmf.ResourceDescriptor().GroupKind().Kind,
+		)],
 	}
 }
@@ -16,17 +16,21 @@ package runtime_test
 import (
 	"context"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap/zapcore"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sobj "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	k8srtschema "k8s.io/apimachinery/pkg/runtime/schema"
+	ctrlrt "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	ctrlrtzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
@@ -85,13 +89,23 @@ func reconcilerMocks(
 	acktypes.AWSResourceReconciler,
 	*ctrlrtclientmock.Client,
 	acktypes.ServiceControllerMetadata,
+) {
+	return reconcilerMocksWithConfig(rmf, ackcfg.Config{})
+}
+
+func reconcilerMocksWithConfig(
+	rmf acktypes.AWSResourceManagerFactory,
+	cfg ackcfg.Config,
+) (
+	acktypes.AWSResourceReconciler,
+	*ctrlrtclientmock.Client,
+	acktypes.ServiceControllerMetadata,
 ) {
 	zapOptions := ctrlrtzap.Options{
 		Development: true,
 		Level:       zapcore.InfoLevel,
 	}
 	fakeLogger := ctrlrtzap.New(ctrlrtzap.UseFlagOptions(&zapOptions))
-	cfg := ackcfg.Config{}
 	metrics := ackmetrics.NewMetrics("bookstore")
 
 	sc := &ackmocks.ServiceController{}
@@ -276,93 +290,173 @@ func TestReconcilerCreate_UnManagedResource_CheckReferencesResolveTwice(t *testi
 	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
 }
 
-func TestReconcilerCreate_ManagedResource_CheckReferencesResolveOnce(t *testing.T) {
+func TestReconcilerCreate_CreateBudgetExhausted(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
-	arn := ackv1alpha1.AWSResourceName("mybook-arn")
 
 	desired, _, _ := resourceMocks()
-	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+	desired.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	syncedReasons := []string{}
+	desired.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		for _, cond := range args.Get(0).([]*ackv1alpha1.Condition) {
+			if cond.Type == ackv1alpha1.ConditionTypeResourceSynced && cond.Reason != nil {
+				syncedReasons = append(syncedReasons, *cond.Reason)
+			}
+		}
+	})
 
-	ids := &ackmocks.AWSResourceIdentifiers{}
-	ids.On("ARN").Return(&arn)
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(nil, ackerr.NotFound)
+	rm.On("IsSynced", ctx, desired).Return(false, nil)
 
-	latest, latestRTObj, _ := resourceMocks()
-	latest.On("Identifiers").Return(ids)
+	rmf, rd := managedResourceManagerFactoryMocks(desired, nil)
+	rd.On("IsManaged", desired).Return(true)
+	r, _, scmd := reconcilerMocksWithConfig(rmf, ackcfg.Config{
+		CreateBudgets: []string{"fakebook=0"},
+	})
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
 
-	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
-	latest.On(
+	// The creation is deferred until a slot of the budget is available
+	latest, err := r.Sync(ctx, rm, desired)
+	require.Equal(desired, latest)
+	var requeueNeededAfter *requeue.RequeueNeededAfter
+	require.True(errors.As(err, &requeueNeededAfter))
+	require.ErrorIs(err, ackerr.CreateBudgetExhausted)
+	rm.AssertNotCalled(t, "Create", ctx, desired)
+	require.NotEmpty(syncedReasons)
+	require.Equal(ackcondition.CreateBudgetExhaustedReason, syncedReasons[0])
+}
+
+// referenceValidatorManager is an AWSResourceManager validating the
+// references of its resources
+type referenceValidatorManager struct {
+	*ackmocks.AWSResourceManager
+	err error
+}
+
+func (rm *referenceValidatorManager) ValidateReferences(
+	ctx context.Context,
+	apiReader client.Reader,
+	res acktypes.AWSResource,
+) error {
+	return rm.err
+}
+
+func TestReconcilerCreate_ReferenceMismatch(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+
+	desired, _, _ := resourceMocks()
+	desired.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	terminalMessages := []string{}
+	desired.On(
 		"ReplaceConditions",
 		mock.AnythingOfType("[]*v1alpha1.Condition"),
 	).Return().Run(func(args mock.Arguments) {
-		conditions := args.Get(0).([]*ackv1alpha1.Condition)
-		assert.Equal(t, 1, len(conditions))
-		cond := conditions[0]
-		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
-		assert.Equal(t, corev1.ConditionTrue, cond.Status)
+		for _, cond := range args.Get(0).([]*ackv1alpha1.Condition) {
+			if cond.Type == ackv1alpha1.ConditionTypeTerminal && cond.Message != nil {
+				terminalMessages = append(terminalMessages, *cond.Message)
+			}
+		}
 	})
 
 	rm := &ackmocks.AWSResourceManager{}
-	rm.On("ResolveReferences", ctx, nil, desired).Return(
-		desired, nil,
-	).Once()
-	rm.On("ReadOne", ctx, desired).Return(
-		latest, ackerr.NotFound,
-	).Once()
-	rm.On("ReadOne", ctx, latest).Return(
-		latest, nil,
-	)
-	rm.On("Create", ctx, desired).Return(
-		latest, nil,
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("IsSynced", ctx, desired).Return(false, nil)
+
+	rmf, _ := managedResourceManagerFactoryMocks(desired, nil)
+	r, _, _ := reconcilerMocks(rmf)
+
+	mismatch := ackerr.ResourceReferenceMismatchFor(
+		"ec2.services.k8s.aws/Subnet/default/my-subnet", "spec.vpcID", "vpc-1", "vpc-2",
 	)
-	rm.On("IsSynced", ctx, latest).Return(true, nil)
-	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	// The AWS resource is neither read nor created
+	_, err := r.Sync(ctx, &referenceValidatorManager{rm, mismatch}, desired)
+	require.Equal(ackerr.Terminal, err)
+	rm.AssertNotCalled(t, "ReadOne", ctx, desired)
+	rm.AssertNotCalled(t, "Create", ctx, desired)
+	require.NotEmpty(terminalMessages)
+	require.Equal(mismatch.Error(), terminalMessages[0])
+}
 
-	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
-	rd.On("IsManaged", desired).Return(true)
-	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
-	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+func TestReconcilerCreate_AsyncCreateInProgress(t *testing.T) {
+	require := require.New(t)
 
-	r, kc, scmd := reconcilerMocks(rmf)
+	ctx := context.TODO()
+
+	desired, _, desiredMetaObj := resourceMocks()
+	desiredMetaObj.SetAnnotations(map[string]string{
+		ackv1alpha1.AnnotationCreateInProgress: "2024-01-31T22:00:00Z",
+	})
+	desired.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	syncedReasons := []string{}
+	desired.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		for _, cond := range args.Get(0).([]*ackv1alpha1.Condition) {
+			if cond.Type == ackv1alpha1.ConditionTypeResourceSynced && cond.Reason != nil {
+				syncedReasons = append(syncedReasons, *cond.Reason)
+			}
+		}
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(nil, ackerr.NotFound)
+	rm.On("IsSynced", ctx, desired).Return(false, nil)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, nil)
+	rd.On("IsManaged", desired).Return(true)
+	r, _, scmd := reconcilerMocksWithConfig(rmf, ackcfg.Config{
+		AsyncCreatePollKinds:   []string{"*"},
+		AsyncCreatePollSeconds: 45,
+	})
 	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
 
-	// pointers returned from "client.MergeFrom" fails the equality check during
-	// assertion even when parameters inside two objects are same.
-	// hence we use mock.AnythingOfType parameter to assert patch call
-	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+	// The AWS resource is not created again while its creation is in progress
+	latest, err := r.Sync(ctx, rm, desired)
+	require.Equal(desired, latest)
+	var requeueNeededAfter *requeue.RequeueNeededAfter
+	require.True(errors.As(err, &requeueNeededAfter))
+	require.Equal(45*time.Second, requeueNeededAfter.Duration())
+	rm.AssertNotCalled(t, "Create", ctx, desired)
+	require.NotEmpty(syncedReasons)
+	require.Equal(ackcondition.CreateInProgressReason, syncedReasons[0])
+}
 
-	// With the above mocks and below assertions, we check that if we got a
-	// NotFound error return from `AWSResourceManager.ReadOne()` that we end
-	// up calling the AWSResourceManager.Create() call in the Reconciler.Sync()
-	// method,
-	_, err := r.Sync(ctx, rm, desired)
-	require.Nil(err)
-	// Make sure references are resolved once for the resource creation when
-	// the resource is already managed
-	rm.AssertNumberOfCalls(t, "ResolveReferences", 1)
-	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
-	rm.AssertCalled(t, "ReadOne", ctx, desired)
-	rm.AssertCalled(t, "Create", ctx, desired)
-	// No changes to metadata or spec so Patch on the object shouldn't be done
-	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
-	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
-	kc.AssertNotCalled(t, "Status")
-	rm.AssertCalled(t, "LateInitialize", ctx, latest)
-	rm.AssertCalled(t, "IsSynced", ctx, latest)
-	rm.AssertNumberOfCalls(t, "EnsureTags", 1)
-	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+// clientAssignedIdentifierDescriptor is an AWSResourceDescriptor declaring
+// client-assigned identifiers
+type clientAssignedIdentifierDescriptor struct {
+	*ackmocks.AWSResourceDescriptor
 }
 
-func TestReconcilerUpdate(t *testing.T) {
+func (rd *clientAssignedIdentifierDescriptor) HasClientAssignedIdentifier() bool {
+	return true
+}
+
+// tagAfterCreateDescriptor is an AWSResourceDescriptor declaring the
+// tag-after-create tagging model
+type tagAfterCreateDescriptor struct {
+	*ackmocks.AWSResourceDescriptor
+}
+
+func (rd *tagAfterCreateDescriptor) TaggingModel() acktypes.TaggingModel {
+	return acktypes.TaggingModelTagAfterCreate
+}
+
+func TestReconcilerCreate_TagAfterCreate(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
 
-	delta := ackcompare.NewDelta()
-	delta.Add("Spec.A", "val1", "val2")
-
 	desired, _, _ := resourceMocks()
 	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
 
@@ -371,160 +465,114 @@ func TestReconcilerUpdate(t *testing.T) {
 
 	latest, latestRTObj, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
-
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
 		"ReplaceConditions",
 		mock.AnythingOfType("[]*v1alpha1.Condition"),
-	).Return().Run(func(args mock.Arguments) {
-		conditions := args.Get(0).([]*ackv1alpha1.Condition)
-		assert.Equal(t, 1, len(conditions))
-		cond := conditions[0]
-		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
-		assert.Equal(t, corev1.ConditionTrue, cond.Status)
-	})
+	).Return()
 
+	calls := []string{}
 	rm := &ackmocks.AWSResourceManager{}
-	rm.On("ResolveReferences", ctx, nil, desired).Return(
-		desired, nil,
-	).Once()
-	rm.On("ReadOne", ctx, desired).Return(
-		latest, nil,
-	)
-	rm.On("Update", ctx, desired, latest, delta).Return(
-		latest, nil,
-	)
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(nil, ackerr.NotFound)
+	rm.On("ReadOne", ctx, latest).Return(latest, nil)
+	rm.On("Create", ctx, desired).Return(latest, nil).Run(func(mock.Arguments) {
+		calls = append(calls, "Create")
+	})
 	rm.On("IsSynced", ctx, latest).Return(true, nil)
-	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
-	rd.On("Delta", desired, latest).Return(
-		delta,
-	).Once()
-	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
-
 	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+
+	_, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("IsManaged", desired).Return(false).Once()
+	rd.On("IsManaged", desired).Return(true)
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
 	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
 
-	r, kc, scmd := reconcilerMocks(rmf)
-	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+	rmf := &ackmocks.AWSResourceManagerFactory{}
+	rmf.On("ResourceDescriptor").Return(&tagAfterCreateDescriptor{rd})
+	rmf.On("RequeueOnSuccessSeconds").Return(0)
 
-	// pointers returned from "client.MergeFrom" fails the equality check during
-	// assertion even when parameters inside two objects are same.
-	// hence we use mock.AnythingOfType parameter to assert patch call
+	r, kc, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, latest, scmd).Return(nil).Run(func(mock.Arguments) {
+		calls = append(calls, "EnsureTags")
+	})
 	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
 
-	// With the above mocks and below assertions, we check that if we got a
-	// non-error return from `AWSResourceManager.ReadOne()` and the
-	// `AWSResourceDescriptor.Delta()` returned a non-empty Delta, that we end
-	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
-	// method,
+	// The controller tags are only added once the AWS resource exists
 	_, err := r.Sync(ctx, rm, desired)
 	require.Nil(err)
-	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
-	// Assert that References are resolved only once during resource update
-	rm.AssertNumberOfCalls(t, "ResolveReferences", 1)
-	rm.AssertCalled(t, "ReadOne", ctx, desired)
-	rd.AssertCalled(t, "Delta", desired, latest)
-	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
-	// No changes to metadata or spec so Patch on the object shouldn't be done
-	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
-	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
-	kc.AssertNotCalled(t, "Status")
-	rm.AssertCalled(t, "LateInitialize", ctx, latest)
-	rm.AssertCalled(t, "IsSynced", ctx, latest)
-	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+	require.Equal([]string{"Create", "EnsureTags"}, calls)
+	rm.AssertNotCalled(t, "EnsureTags", ctx, desired, scmd)
 }
 
-func TestReconcilerUpdate_ResourceNotSynced(t *testing.T) {
+// costEstimatorManager is an AWSResourceManager estimating the cost of its
+// AWS resources
+type costEstimatorManager struct {
+	*ackmocks.AWSResourceManager
+	cost float64
+}
+
+func (rm *costEstimatorManager) EstimateMonthlyCost(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) (float64, error) {
+	return rm.cost, nil
+}
+
+func TestReconcilerCreate_EstimateMonthlyCost(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
-
-	delta := ackcompare.NewDelta()
-	delta.Add("Spec.A", "val1", "val2")
+	accountID := ackv1alpha1.AWSAccountID("123456789012")
 
 	desired, _, _ := resourceMocks()
 	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
 
 	ids := &ackmocks.AWSResourceIdentifiers{}
 	ids.On("ARN").Return(&arn)
+	ids.On("OwnerAccountID").Return(&accountID)
 
-	latest, latestRTObj, _ := resourceMocks()
+	latest, latestRTObj, latestMetaObj := resourceMocks()
 	latest.On("Identifiers").Return(ids)
-
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
 		"ReplaceConditions",
 		mock.AnythingOfType("[]*v1alpha1.Condition"),
-	).Return().Run(func(args mock.Arguments) {
-		conditions := args.Get(0).([]*ackv1alpha1.Condition)
-		assert.Equal(t, 1, len(conditions))
-		cond := conditions[0]
-		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
-		// Synced condition is false because rm.IsSynced() method returns
-		// False
-		assert.Equal(t, corev1.ConditionFalse, cond.Status)
-		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
-	})
+	).Return()
 
 	rm := &ackmocks.AWSResourceManager{}
-	rm.On("ResolveReferences", ctx, nil, desired).Return(
-		desired, nil,
-	)
-	rm.On("ReadOne", ctx, desired).Return(
-		latest, nil,
-	)
-	rm.On("Update", ctx, desired, latest, delta).Return(
-		latest, nil,
-	)
-	rm.On("IsSynced", ctx, latest).Return(false, nil)
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(nil, ackerr.NotFound)
+	rm.On("ReadOne", ctx, latest).Return(latest, nil)
+	rm.On("Create", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
 
 	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
-	rd.On("Delta", desired, latest).Return(
-		delta,
-	).Once()
-	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
-
-	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rd.On("IsManaged", desired).Return(false).Once()
+	rd.On("IsManaged", desired).Return(true)
 	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
 
 	r, kc, scmd := reconcilerMocks(rmf)
 	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
-
-	// pointers returned from "client.MergeFrom" fails the equality check during
-	// assertion even when parameters inside two objects are same.
-	// hence we use mock.AnythingOfType parameter to assert patch call
 	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
 
-	// With the above mocks and below assertions, we check that if we got a
-	// non-error return from `AWSResourceManager.ReadOne()` and the
-	// `AWSResourceDescriptor.Delta()` returned a non-empty Delta, that we end
-	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
-	// method,
-	_, err := r.Sync(ctx, rm, desired)
+	_, err := r.Sync(ctx, &costEstimatorManager{rm, 12.5}, desired)
 	require.Nil(err)
-	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
-	rm.AssertCalled(t, "ReadOne", ctx, desired)
-	rd.AssertCalled(t, "Delta", desired, latest)
-	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
-	// No changes to metadata or spec so Patch on the object shouldn't be done
-	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
-	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
-	kc.AssertNotCalled(t, "Status")
-	rm.AssertCalled(t, "LateInitialize", ctx, latest)
-	rm.AssertCalled(t, "IsSynced", ctx, latest)
-	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+	require.Equal(
+		"12.50",
+		latestMetaObj.GetAnnotations()[ackv1alpha1.AnnotationEstimatedMonthlyCost],
+	)
 }
 
-func TestReconcilerUpdate_NoDelta_ResourceNotSynced(t *testing.T) {
+func TestReconcilerCreate_FinalizerOverride(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
 
-	delta := ackcompare.NewDelta()
-
-	desired, _, _ := resourceMocks()
+	desired, desiredRTObj, desiredMetaObj := resourceMocks()
 	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
 
 	ids := &ackmocks.AWSResourceIdentifiers{}
@@ -532,73 +580,176 @@ func TestReconcilerUpdate_NoDelta_ResourceNotSynced(t *testing.T) {
 
 	latest, latestRTObj, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
-
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
 		"ReplaceConditions",
 		mock.AnythingOfType("[]*v1alpha1.Condition"),
-	).Return().Run(func(args mock.Arguments) {
-		conditions := args.Get(0).([]*ackv1alpha1.Condition)
-		assert.Equal(t, 1, len(conditions))
-		cond := conditions[0]
-		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
-		// Synced condition is false because rm.IsSynced() method returns
-		// False
-		assert.Equal(t, corev1.ConditionFalse, cond.Status)
-		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
-	})
+	).Return()
 
 	rm := &ackmocks.AWSResourceManager{}
-	rm.On("ResolveReferences", ctx, nil, desired).Return(
-		desired, nil,
-	)
-	rm.On("ReadOne", ctx, desired).Return(
-		latest, nil,
-	)
-	rm.On("IsSynced", ctx, latest).Return(false, nil)
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(nil, ackerr.NotFound)
+	rm.On("ReadOne", ctx, latest).Return(latest, nil)
+	rm.On("Create", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
 
 	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
-	rd.On("Delta", desired, latest).Return(delta)
+	rd.On("IsManaged", desired).Return(false)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+	rd.On("Delta", desired, desired).Return(ackcompare.NewDelta())
+	rd.On("ResourceFromRuntimeObject", mock.Anything).Return(desired)
+
+	cfg := ackcfg.Config{FinalizerOverride: "example.com/finalizer"}
+	r, kc, scmd := reconcilerMocksWithConfig(rmf, cfg)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+	kc.On("Patch", ctx, desiredRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	_, err := r.Sync(ctx, rm, desired)
+	require.Nil(err)
+	require.Equal([]string{"example.com/finalizer"}, desiredMetaObj.GetFinalizers())
+	rd.AssertNotCalled(t, "MarkManaged", desired)
+}
+
+// recordingTracerProvider is a trace.TracerProvider recording the names of
+// the spans started by its tracers.
+type recordingTracerProvider struct {
+	spans []string
+}
+
+func (p *recordingTracerProvider) Tracer(
+	string, ...trace.TracerOption,
+) trace.Tracer {
+	return p
+}
+
+func (p *recordingTracerProvider) Start(
+	ctx context.Context,
+	name string,
+	opts ...trace.SpanStartOption,
+) (context.Context, trace.Span) {
+	p.spans = append(p.spans, name)
+	return trace.NewNoopTracerProvider().Tracer("").Start(ctx, name, opts...)
+}
+
+func TestReconcilerCreate_TraceOperations(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, latestRTObj, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return()
 
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(nil, ackerr.NotFound)
+	rm.On("ReadOne", ctx, latest).Return(latest, nil)
+	rm.On("Create", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
 	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
-	rd.On("Delta", latest, latest).Return(delta)
 
-	r, kc, scmd := reconcilerMocks(rmf)
-	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("IsManaged", desired).Return(false).Once()
+	rd.On("IsManaged", desired).Return(true)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
 
-	// pointers returned from "client.MergeFrom" fails the equality check during
-	// assertion even when parameters inside two objects are same.
-	// hence we use mock.AnythingOfType parameter to assert patch call
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
+
+	tp := &recordingTracerProvider{}
+	r, kc, scmd := reconcilerMocksWithConfig(rmf, ackcfg.Config{TracerProvider: tp})
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
 	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
 
-	// With the above mocks and below assertions, we check that if we got a
-	// non-error return from `AWSResourceManager.ReadOne()` and the
-	// `AWSResourceDescriptor.Delta()` returned a non-empty Delta, that we end
-	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
-	// method,
 	_, err := r.Sync(ctx, rm, desired)
 	require.Nil(err)
-	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
-	rm.AssertCalled(t, "ReadOne", ctx, desired)
-	rd.AssertCalled(t, "Delta", desired, latest)
-	// Update is not called because there is no delta
-	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
-	// No changes to metadata or spec so Patch on the object shouldn't be done
-	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
-	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
-	kc.AssertNotCalled(t, "Status")
-	rm.AssertCalled(t, "LateInitialize", ctx, latest)
-	rm.AssertCalled(t, "IsSynced", ctx, latest)
-	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+	require.Equal([]string{
+		"rm.ResolveReferences",
+		"rm.EnsureTags",
+		"rm.ReadOne",
+		// The references are resolved and the tags ensured again before
+		// creating the AWS resource
+		"rm.ResolveReferences",
+		"rm.EnsureTags",
+		"rm.Create",
+		"rm.ReadOne",
+		"rm.LateInitialize",
+	}, tp.spans)
 }
 
-func TestReconcilerUpdate_NoDelta_ResourceSynced(t *testing.T) {
+func TestReconcilerCreate_SkipInitialRead(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
 
-	delta := ackcompare.NewDelta()
+	desiredIDs := &ackmocks.AWSResourceIdentifiers{}
+	desiredIDs.On("ARN").Return(nil)
+	desiredIDs.On("OwnerAccountID").Return(nil)
+
+	desired, _, _ := resourceMocks()
+	desired.On("Identifiers").Return(desiredIDs)
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, latestRTObj, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return()
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, latest).Return(latest, nil)
+	rm.On("Create", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+
+	_, rd := managedResourceManagerFactoryMocks(desired, latest)
+	// The resource is not managed until it is marked managed before creation
+	rd.On("IsManaged", desired).Return(false).Twice()
+	rd.On("IsManaged", desired).Return(true)
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+
+	rmf := &ackmocks.AWSResourceManagerFactory{}
+	rmf.On("ResourceDescriptor").Return(&clientAssignedIdentifierDescriptor{rd})
+	rmf.On("RequeueOnSuccessSeconds").Return(0)
+
+	r, kc, scmd := reconcilerMocksWithConfig(rmf, ackcfg.Config{
+		SkipInitialReadKinds: []string{"fakebook"},
+	})
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	// The AWS resource is created without first being read
+	_, err := r.Sync(ctx, rm, desired)
+	require.Nil(err)
+	rm.AssertNotCalled(t, "ReadOne", ctx, desired)
+	rm.AssertCalled(t, "Create", ctx, desired)
+}
+
+func TestReconcilerCreate_ManagedResource_CheckReferencesResolveOnce(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
 
 	desired, _, _ := resourceMocks()
 	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
@@ -618,26 +769,29 @@ func TestReconcilerUpdate_NoDelta_ResourceSynced(t *testing.T) {
 		assert.Equal(t, 1, len(conditions))
 		cond := conditions[0]
 		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
-		// Synced condition is true because rm.IsSynced() method returns
-		// True
 		assert.Equal(t, corev1.ConditionTrue, cond.Status)
-		assert.Equal(t, ackcondition.SyncedMessage, *cond.Message)
 	})
 
 	rm := &ackmocks.AWSResourceManager{}
 	rm.On("ResolveReferences", ctx, nil, desired).Return(
 		desired, nil,
-	)
+	).Once()
 	rm.On("ReadOne", ctx, desired).Return(
+		latest, ackerr.NotFound,
+	).Once()
+	rm.On("ReadOne", ctx, latest).Return(
+		latest, nil,
+	)
+	rm.On("Create", ctx, desired).Return(
 		latest, nil,
 	)
 	rm.On("IsSynced", ctx, latest).Return(true, nil)
-
 	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
-	rd.On("Delta", desired, latest).Return(delta)
 
 	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
-	rd.On("Delta", latest, latest).Return(delta)
+	rd.On("IsManaged", desired).Return(true)
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
 
 	r, kc, scmd := reconcilerMocks(rmf)
 	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
@@ -648,27 +802,28 @@ func TestReconcilerUpdate_NoDelta_ResourceSynced(t *testing.T) {
 	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
 
 	// With the above mocks and below assertions, we check that if we got a
-	// non-error return from `AWSResourceManager.ReadOne()` and the
-	// `AWSResourceDescriptor.Delta()` returned a non-empty Delta, that we end
-	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
+	// NotFound error return from `AWSResourceManager.ReadOne()` that we end
+	// up calling the AWSResourceManager.Create() call in the Reconciler.Sync()
 	// method,
 	_, err := r.Sync(ctx, rm, desired)
 	require.Nil(err)
+	// Make sure references are resolved once for the resource creation when
+	// the resource is already managed
+	rm.AssertNumberOfCalls(t, "ResolveReferences", 1)
 	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
 	rm.AssertCalled(t, "ReadOne", ctx, desired)
-	rd.AssertCalled(t, "Delta", desired, latest)
-	// Update is not called because there is no delta
-	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+	rm.AssertCalled(t, "Create", ctx, desired)
 	// No changes to metadata or spec so Patch on the object shouldn't be done
 	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
 	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
 	kc.AssertNotCalled(t, "Status")
 	rm.AssertCalled(t, "LateInitialize", ctx, latest)
 	rm.AssertCalled(t, "IsSynced", ctx, latest)
+	rm.AssertNumberOfCalls(t, "EnsureTags", 1)
 	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
 }
 
-func TestReconcilerUpdate_IsSyncedError(t *testing.T) {
+func TestReconcilerUpdate(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
@@ -686,8 +841,6 @@ func TestReconcilerUpdate_IsSyncedError(t *testing.T) {
 	latest, latestRTObj, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
 
-	syncedError := errors.New("rm.IsSynced failed")
-
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
 		"ReplaceConditions",
@@ -697,26 +850,20 @@ func TestReconcilerUpdate_IsSyncedError(t *testing.T) {
 		assert.Equal(t, 1, len(conditions))
 		cond := conditions[0]
 		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
-		// Synced condition is false because rm.IsSynced() method returns
-		// an error
-		assert.Equal(t, corev1.ConditionFalse, cond.Status)
-		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
-		assert.Equal(t, syncedError.Error(), *cond.Reason)
+		assert.Equal(t, corev1.ConditionTrue, cond.Status)
 	})
 
 	rm := &ackmocks.AWSResourceManager{}
 	rm.On("ResolveReferences", ctx, nil, desired).Return(
 		desired, nil,
-	)
+	).Once()
 	rm.On("ReadOne", ctx, desired).Return(
 		latest, nil,
 	)
 	rm.On("Update", ctx, desired, latest, delta).Return(
 		latest, nil,
 	)
-	rm.On("IsSynced", ctx, latest).Return(
-		true, syncedError)
-
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
 	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
 	rd.On("Delta", desired, latest).Return(
 		delta,
@@ -742,6 +889,8 @@ func TestReconcilerUpdate_IsSyncedError(t *testing.T) {
 	_, err := r.Sync(ctx, rm, desired)
 	require.Nil(err)
 	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
+	// Assert that References are resolved only once during resource update
+	rm.AssertNumberOfCalls(t, "ResolveReferences", 1)
 	rm.AssertCalled(t, "ReadOne", ctx, desired)
 	rd.AssertCalled(t, "Delta", desired, latest)
 	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
@@ -754,7 +903,47 @@ func TestReconcilerUpdate_IsSyncedError(t *testing.T) {
 	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
 }
 
-func TestReconcilerUpdate_PatchMetadataAndSpec_DiffInMetadata(t *testing.T) {
+func TestReconcilerUpdate_RateLimited(t *testing.T) {
+	require := require.New(t)
+
+	// The deadline is too close for the Update call to wait for its turn
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	latest, _, _ := resourceMocks()
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return()
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(false, nil)
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(delta)
+
+	r, _, scmd := reconcilerMocksWithConfig(rmf, ackcfg.Config{
+		ResourceRateLimits: []string{"fakeBook=1"},
+	})
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	_, err := r.Sync(ctx, rm, desired)
+	var requeueNeededAfter *requeue.RequeueNeededAfter
+	require.True(errors.As(err, &requeueNeededAfter))
+	require.Greater(requeueNeededAfter.Duration(), time.Duration(0))
+	rm.AssertCalled(t, "ReadOne", ctx, desired)
+	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+}
+
+func TestReconcilerUpdate_NotImplemented(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
@@ -769,7 +958,7 @@ func TestReconcilerUpdate_PatchMetadataAndSpec_DiffInMetadata(t *testing.T) {
 	ids := &ackmocks.AWSResourceIdentifiers{}
 	ids.On("ARN").Return(&arn)
 
-	latest, latestRTObj, latestMetaObj := resourceMocks()
+	latest, _, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
@@ -777,132 +966,108 @@ func TestReconcilerUpdate_PatchMetadataAndSpec_DiffInMetadata(t *testing.T) {
 		mock.AnythingOfType("[]*v1alpha1.Condition"),
 	).Return()
 
-	// Note the change in annotations
-	latestMetaObj.SetAnnotations(map[string]string{"a": "b"})
-
-	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
-	rd.On("Delta", desired, latest).Return(
-		delta,
-	).Once()
-	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
-
 	rm := &ackmocks.AWSResourceManager{}
-	rm.On("ResolveReferences", ctx, nil, desired).Return(
-		desired, nil,
-	)
-	rm.On("ReadOne", ctx, desired).Return(
-		latest, nil,
-	)
-	rm.On("Update", ctx, desired, latest, delta).Return(
-		latest, nil,
-	)
-	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(latest, nil)
+	rm.On("Update", ctx, desired, latest, delta).Return(latest, nil)
 	rm.On("IsSynced", ctx, latest).Return(true, nil)
-	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(delta).Once()
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
 
-	r, kc, scmd := reconcilerMocks(rmf)
-	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+	// The resource manager of a kind that is not fully code-generated yet
+	// does not implement late initialization nor tagging.
+	rm.On("LateInitialize", ctx, latest).Return(nil, ackerr.NotImplemented)
 
-	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+	r, _, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(ackerr.NotImplemented)
 
-	_, err := r.Sync(ctx, rm, desired)
+	got, err := r.Sync(ctx, rm, desired)
 	require.Nil(err)
-	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
-	rm.AssertCalled(t, "ReadOne", ctx, desired)
-	rd.AssertCalled(t, "Delta", desired, latest)
+	require.Equal(latest, got)
 	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
-	kc.AssertCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
-	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
-	kc.AssertNotCalled(t, "Status")
 	rm.AssertCalled(t, "LateInitialize", ctx, latest)
-	latest.AssertCalled(t, "DeepCopy")
-	latest.AssertCalled(t, "SetStatus", latest)
 	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
 }
 
-func TestReconcilerUpdate_PatchMetadataAndSpec_DiffInSpec(t *testing.T) {
+// derivedStatusResourceManager is an AWSResourceManager registering derived
+// status computations
+type derivedStatusResourceManager struct {
+	*ackmocks.AWSResourceManager
+	computations []acktypes.DerivedStatusFunc
+}
+
+func (rm *derivedStatusResourceManager) DerivedStatusComputations() []acktypes.DerivedStatusFunc {
+	return rm.computations
+}
+
+func TestReconcilerUpdate_DerivedStatus(t *testing.T) {
 	require := require.New(t)
-	assert := assert.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
 
-	delta := ackcompare.NewDelta()
-	delta.Add("Spec.A", "val1", "val2")
-
 	desired, _, _ := resourceMocks()
 	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
 
 	ids := &ackmocks.AWSResourceIdentifiers{}
 	ids.On("ARN").Return(&arn)
 
-	latest, latestRTObj, _ := resourceMocks()
+	latest, _, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
 		"ReplaceConditions",
 		mock.AnythingOfType("[]*v1alpha1.Condition"),
-	).Return().Run(func(args mock.Arguments) {
-		conditions := args.Get(0).([]*ackv1alpha1.Condition)
-		hasSynced := false
-		for _, condition := range conditions {
-			if condition.Type != ackv1alpha1.ConditionTypeResourceSynced {
-				continue
-			}
-
-			hasSynced = true
-			assert.Equal(corev1.ConditionTrue, condition.Status)
-			assert.Equal(ackcondition.SyncedMessage, *condition.Message)
-		}
-		assert.True(hasSynced)
-	})
-	// Note no change to metadata...
+	).Return()
 
+	mockRM := &ackmocks.AWSResourceManager{}
+	mockRM.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	mockRM.On("ReadOne", ctx, desired).Return(latest, nil)
+	mockRM.On("LateInitialize", ctx, latest).Return(latest, nil)
+	mockRM.On("IsSynced", ctx, latest).Return(true, nil)
 	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
-	rd.On("Delta", desired, latest).Return(
-		delta,
-	)
-
-	rm := &ackmocks.AWSResourceManager{}
-	rm.On("ResolveReferences", ctx, nil, desired).Return(
-		desired, nil,
-	)
-	rm.On("ReadOne", ctx, desired).Return(
-		latest, nil,
-	)
-	rm.On("Update", ctx, desired, latest, delta).Return(
-		latest, nil,
-	)
-	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
-	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
 	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
 
-	r, kc, scmd := reconcilerMocks(rmf)
-	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
-
-	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+	r, _, scmd := reconcilerMocks(rmf)
+	mockRM.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	// A failing computation does not prevent the following ones from running
+	// nor the resource from being synced.
+	computed := []acktypes.AWSResource{}
+	rm := &derivedStatusResourceManager{
+		AWSResourceManager: mockRM,
+		computations: []acktypes.DerivedStatusFunc{
+			func(_ context.Context, res acktypes.AWSResource) error {
+				return errors.New("health check failed")
+			},
+			func(_ context.Context, res acktypes.AWSResource) error {
+				computed = append(computed, res)
+				return nil
+			},
+		},
+	}
 
 	_, err := r.Sync(ctx, rm, desired)
 	require.Nil(err)
-	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
-	rm.AssertCalled(t, "ReadOne", ctx, desired)
-	rd.AssertCalled(t, "Delta", desired, latest)
-	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
-	kc.AssertCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
-	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
-	kc.AssertNotCalled(t, "Status")
-	rm.AssertCalled(t, "LateInitialize", ctx, latest)
-	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+	require.Len(computed, 1)
+	require.Equal(latest, computed[0])
 }
 
-func TestReconcilerHandleReconcilerError_PatchStatus_Latest(t *testing.T) {
+func TestReconcilerUpdate_PartiallyApplied(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+	updateErr := errors.New("update failed")
 
 	delta := ackcompare.NewDelta()
 	delta.Add("Spec.A", "val1", "val2")
+	delta.Add("Spec.B", "val1", "val2")
+
+	unapplied := ackcompare.NewDelta()
+	unapplied.Add("Spec.B", "val1", "val2")
 
 	desired, _, _ := resourceMocks()
 	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
@@ -910,60 +1075,75 @@ func TestReconcilerHandleReconcilerError_PatchStatus_Latest(t *testing.T) {
 	ids := &ackmocks.AWSResourceIdentifiers{}
 	ids.On("ARN").Return(&arn)
 
-	latest, latestRTObj, latestMetaObj := resourceMocks()
+	latest, _, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
-	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
-	latest.On(
-		"ReplaceConditions",
-		mock.AnythingOfType("[]*v1alpha1.Condition"),
-	).Return()
-
-	latestMetaObj.SetAnnotations(map[string]string{"a": "b"})
-
-	rmf, _ := managedResourceManagerFactoryMocks(desired, latest)
-	r, kc, _ := reconcilerMocks(rmf)
-
-	statusWriter := &ctrlrtclientmock.SubResourceWriter{}
-	kc.On("Status").Return(statusWriter)
-	statusWriter.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
-	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
-
-	_, err := r.HandleReconcileError(ctx, desired, latest, nil)
-	require.Nil(err)
-	statusWriter.AssertCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
-	// The HandleReconcilerError function never updates spec or metadata, so
-	// even though there is a change to the annotations we expect no call to
-	// patch the spec/metadata...
-	kc.AssertNotCalled(t, "Patch")
-}
-
-func TestReconcilerHandleReconcilerError_NoPatchStatus_NoLatest(t *testing.T) {
-	require := require.New(t)
 
-	ctx := context.TODO()
+	observed, _, _ := resourceMocks()
+	observed.On("Identifiers").Return(ids)
+	observed.On("Conditions").Return([]*ackv1alpha1.Condition{})
 
-	desired, _, _ := resourceMocks()
-	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+	var gotConditions []*ackv1alpha1.Condition
+	observed.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		gotConditions = append(
+			gotConditions, args.Get(0).([]*ackv1alpha1.Condition)...,
+		)
+	})
 
-	rmf, _ := managedResourceManagerFactoryMocks(desired, nil)
-	r, kc, _ := reconcilerMocks(rmf)
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(
+		desired, nil,
+	).Once()
+	rm.On("ReadOne", ctx, desired).Return(
+		latest, nil,
+	)
+	rm.On("Update", ctx, desired, latest, delta).Return(
+		nil, updateErr,
+	)
+	rm.On("ReadOne", ctx, latest).Return(
+		observed, nil,
+	)
+	rm.On("IsSynced", ctx, observed).Return(false, nil)
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(delta)
+	rd.On("Delta", desired, observed).Return(unapplied)
 
-	statusWriter := &ctrlrtclientmock.SubResourceWriter{}
-	kc.On("Status").Return(statusWriter)
+	r, _, scmd := reconcilerMocksWithConfig(
+		rmf, ackcfg.Config{ReconcileReadAfterUpdateFailure: true},
+	)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
 
-	_, err := r.HandleReconcileError(ctx, desired, nil, nil)
-	require.Nil(err)
-	// If latest is nil, we should not call patch status...
-	statusWriter.AssertNotCalled(t, "Patch")
-	// The HandleReconcilerError function never updates spec or metadata, so
-	// even though there is a change to the annotations we expect no call to
-	// patch the spec/metadata...
-	kc.AssertNotCalled(t, "Patch")
+	got, err := r.Sync(ctx, rm, desired)
+	require.Equal(updateErr, err)
+	require.Equal(observed, got)
+	rm.AssertCalled(t, "ReadOne", ctx, latest)
+	rm.AssertNotCalled(t, "LateInitialize", ctx, observed)
+
+	var advisory, synced *ackv1alpha1.Condition
+	for _, cond := range gotConditions {
+		switch cond.Type {
+		case ackv1alpha1.ConditionTypeAdvisory:
+			advisory = cond
+		case ackv1alpha1.ConditionTypeResourceSynced:
+			synced = cond
+		}
+	}
+	// The reason of the ResourceSynced condition names the failed operation
+	require.NotNil(synced)
+	require.Equal(corev1.ConditionUnknown, synced.Status)
+	require.Equal("update failed: "+updateErr.Error(), *synced.Reason)
+
+	require.NotNil(advisory)
+	require.Equal(corev1.ConditionTrue, advisory.Status)
+	require.Equal(ackcondition.UpdatePartiallyAppliedMessage, *advisory.Message)
+	require.Contains(*advisory.Reason, "Spec.B")
+	require.NotContains(*advisory.Reason, "Spec.A")
 }
 
-func TestReconcilerUpdate_ErrorInLateInitialization(t *testing.T) {
+func TestReconcilerUpdate_ResourceNotSynced(t *testing.T) {
 	require := require.New(t)
-	assert := assert.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
@@ -977,31 +1157,22 @@ func TestReconcilerUpdate_ErrorInLateInitialization(t *testing.T) {
 	ids := &ackmocks.AWSResourceIdentifiers{}
 	ids.On("ARN").Return(&arn)
 
-	requeueError := requeue.NeededAfter(errors.New("error from late initialization"), time.Duration(0)*time.Second)
-
 	latest, latestRTObj, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
+
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
 		"ReplaceConditions",
 		mock.AnythingOfType("[]*v1alpha1.Condition"),
 	).Return().Run(func(args mock.Arguments) {
 		conditions := args.Get(0).([]*ackv1alpha1.Condition)
-		hasSynced := false
-		for _, condition := range conditions {
-			if condition.Type != ackv1alpha1.ConditionTypeResourceSynced {
-				continue
-			}
-			hasSynced = true
-			// Even though mocked IsSynced method returns (true, nil),
-			// the reconciler error from late initialization correctly causes
-			// the ResourceSynced condition to be Unknown since the reconciler
-			// error is not a Terminal error.
-			assert.Equal(corev1.ConditionUnknown, condition.Status)
-			assert.Equal(ackcondition.UnknownSyncedMessage, *condition.Message)
-			assert.Equal(requeueError.Error(), *condition.Reason)
-		}
-		assert.True(hasSynced)
+		assert.Equal(t, 1, len(conditions))
+		cond := conditions[0]
+		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
+		// Synced condition is false because rm.IsSynced() method returns
+		// False
+		assert.Equal(t, corev1.ConditionFalse, cond.Status)
+		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
 	})
 
 	rm := &ackmocks.AWSResourceManager{}
@@ -1014,6 +1185,7 @@ func TestReconcilerUpdate_ErrorInLateInitialization(t *testing.T) {
 	rm.On("Update", ctx, desired, latest, delta).Return(
 		latest, nil,
 	)
+	rm.On("IsSynced", ctx, latest).Return(false, nil)
 
 	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
 	rd.On("Delta", desired, latest).Return(
@@ -1021,32 +1193,39 @@ func TestReconcilerUpdate_ErrorInLateInitialization(t *testing.T) {
 	).Once()
 	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
 
-	rm.On("LateInitialize", ctx, latest).Return(latest, requeueError)
-	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
 	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
 
 	r, kc, scmd := reconcilerMocks(rmf)
 	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
 
+	// pointers returned from "client.MergeFrom" fails the equality check during
+	// assertion even when parameters inside two objects are same.
+	// hence we use mock.AnythingOfType parameter to assert patch call
 	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
 
+	// With the above mocks and below assertions, we check that if we got a
+	// non-error return from `AWSResourceManager.ReadOne()` and the
+	// `AWSResourceDescriptor.Delta()` returned a non-empty Delta, that we end
+	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
+	// method,
 	_, err := r.Sync(ctx, rm, desired)
-	// Assert the error from late initialization
-	require.NotNil(err)
-	assert.Equal(requeueError, err)
+	require.Nil(err)
 	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
 	rm.AssertCalled(t, "ReadOne", ctx, desired)
 	rd.AssertCalled(t, "Delta", desired, latest)
 	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
-	// No difference in desired, latest metadata and spec
+	// No changes to metadata or spec so Patch on the object shouldn't be done
 	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
+	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
+	kc.AssertNotCalled(t, "Status")
 	rm.AssertCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertCalled(t, "IsSynced", ctx, latest)
 	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
 }
 
-func TestReconcilerUpdate_ResourceNotManaged(t *testing.T) {
+func TestReconcilerUpdate_NoDelta_ResourceNotSynced(t *testing.T) {
 	require := require.New(t)
-	assert := assert.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
@@ -1059,66 +1238,22 @@ func TestReconcilerUpdate_ResourceNotManaged(t *testing.T) {
 	ids := &ackmocks.AWSResourceIdentifiers{}
 	ids.On("ARN").Return(&arn)
 
-	latest, _, _ := resourceMocks()
+	latest, latestRTObj, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
 
-	terminalCondition := ackv1alpha1.Condition{
-		Type:    ackv1alpha1.ConditionTypeTerminal,
-		Status:  corev1.ConditionTrue,
-		Reason:  &ackcondition.NotManagedReason,
-		Message: &ackcondition.NotManagedMessage,
-	}
-	// Return empty conditions for first two times
-	latest.On("Conditions").Return([]*ackv1alpha1.Condition{}).Times(2)
-	// Once the terminal condition is added, return terminal condition
-	// These calls will be made from ensureConditions method, which sets
-	// ACK.ResourceSynced condition correctly
-	latest.On("Conditions").Return([]*ackv1alpha1.Condition{&terminalCondition})
-
-	// Verify once when the terminal condition is set
-	latest.On("ReplaceConditions", mock.AnythingOfType("[]*v1alpha1.Condition")).Return([]*ackv1alpha1.Condition{&terminalCondition}).Run(func(args mock.Arguments) {
-		conditions := args.Get(0).([]*ackv1alpha1.Condition)
-		hasTerminal := false
-		for _, condition := range conditions {
-			if condition.Type != ackv1alpha1.ConditionTypeTerminal {
-				continue
-			}
-
-			hasTerminal = true
-			assert.Equal(terminalCondition.Message, condition.Message)
-			assert.Equal(terminalCondition.Reason, condition.Reason)
-		}
-		assert.True(hasTerminal)
-	}).Once()
-
-	// Verify again when ResourceSynced condition is set that both Terminal
-	// and ResourceSynced condition are present
-	latest.On("ReplaceConditions", mock.AnythingOfType("[]*v1alpha1.Condition")).Return([]*ackv1alpha1.Condition{&terminalCondition}).Run(func(args mock.Arguments) {
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
 		conditions := args.Get(0).([]*ackv1alpha1.Condition)
-		hasTerminal := false
-		for _, condition := range conditions {
-			if condition.Type != ackv1alpha1.ConditionTypeTerminal {
-				continue
-			}
-
-			hasTerminal = true
-			assert.Equal(terminalCondition.Message, condition.Message)
-			assert.Equal(terminalCondition.Reason, condition.Reason)
-		}
-		assert.True(hasTerminal)
-
-		hasSynced := false
-		for _, condition := range conditions {
-			if condition.Type != ackv1alpha1.ConditionTypeResourceSynced {
-				continue
-			}
-			hasSynced = true
-			// The terminal error from reconciler correctly causes
-			// the ResourceSynced condition to be False
-			assert.Equal(corev1.ConditionFalse, condition.Status)
-			assert.Equal(ackcondition.NotSyncedMessage, *condition.Message)
-		}
-		assert.True(hasSynced)
+		assert.Equal(t, 1, len(conditions))
+		cond := conditions[0]
+		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
+		// Synced condition is false because rm.IsSynced() method returns
+		// False
+		assert.Equal(t, corev1.ConditionFalse, cond.Status)
+		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
 	})
 
 	rm := &ackmocks.AWSResourceManager{}
@@ -1128,32 +1263,50 @@ func TestReconcilerUpdate_ResourceNotManaged(t *testing.T) {
 	rm.On("ReadOne", ctx, desired).Return(
 		latest, nil,
 	)
-	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rm.On("IsSynced", ctx, latest).Return(false, nil)
 
-	rmf, rd := managerFactoryMocks(desired, latest, false)
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(delta)
 
-	r, _, scmd := reconcilerMocks(rmf)
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rd.On("Delta", latest, latest).Return(delta)
+
+	r, kc, scmd := reconcilerMocks(rmf)
 	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
 
+	// pointers returned from "client.MergeFrom" fails the equality check during
+	// assertion even when parameters inside two objects are same.
+	// hence we use mock.AnythingOfType parameter to assert patch call
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	// With the above mocks and below assertions, we check that if we got a
+	// non-error return from `AWSResourceManager.ReadOne()` and the
+	// `AWSResourceDescriptor.Delta()` returned a non-empty Delta, that we end
+	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
+	// method,
 	_, err := r.Sync(ctx, rm, desired)
-	require.NotNil(err)
-	assert.Equal(ackerr.Terminal, err)
+	require.Nil(err)
 	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
 	rm.AssertCalled(t, "ReadOne", ctx, desired)
-	rd.AssertNotCalled(t, "Delta", desired, latest)
+	rd.AssertCalled(t, "Delta", desired, latest)
+	// Update is not called because there is no delta
 	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
-	rm.AssertNotCalled(t, "LateInitialize", ctx, latest)
+	// No changes to metadata or spec so Patch on the object shouldn't be done
+	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
+	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
+	kc.AssertNotCalled(t, "Status")
+	rm.AssertCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertCalled(t, "IsSynced", ctx, latest)
 	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
 }
 
-func TestReconcilerUpdate_ResolveReferencesError(t *testing.T) {
+func TestReconcilerUpdate_NoDelta_ResourceSynced(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
 	arn := ackv1alpha1.AWSResourceName("mybook-arn")
 
 	delta := ackcompare.NewDelta()
-	delta.Add("Spec.A", "val1", "val2")
 
 	desired, _, _ := resourceMocks()
 	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
@@ -1164,12 +1317,6 @@ func TestReconcilerUpdate_ResolveReferencesError(t *testing.T) {
 	latest, latestRTObj, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
 
-	resolveReferenceError := errors.New("failed to resolve reference")
-
-	// resourceReconciler.ensureConditions will ensure that if the resource
-	// manager has not set any Conditions on the resource, that at least an
-	// ACK.ResourceSynced condition with status Unknown will be set on the
-	// resource.
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
 		"ReplaceConditions",
@@ -1179,37 +1326,33 @@ func TestReconcilerUpdate_ResolveReferencesError(t *testing.T) {
 		assert.Equal(t, 1, len(conditions))
 		cond := conditions[0]
 		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
-		// The non-terminal reconciler error causes the ResourceSynced
-		// condition to be False
-		assert.Equal(t, corev1.ConditionFalse, cond.Status)
-		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
-		assert.Equal(t, resolveReferenceError.Error(), *cond.Reason)
+		// Synced condition is true because rm.IsSynced() method returns
+		// True
+		assert.Equal(t, corev1.ConditionTrue, cond.Status)
+		assert.Equal(t, ackcondition.SyncedMessage, *cond.Message)
 	})
 
 	rm := &ackmocks.AWSResourceManager{}
 	rm.On("ResolveReferences", ctx, nil, desired).Return(
-		nil, resolveReferenceError,
+		desired, nil,
 	)
 	rm.On("ReadOne", ctx, desired).Return(
 		latest, nil,
 	)
-	rm.On("Update", ctx, desired, latest, delta).Return(
-		latest, nil,
-	)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
 
 	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
-	rd.On("Delta", desired, latest).Return(
-		delta,
-	).Once()
-	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
+	rd.On("Delta", desired, latest).Return(delta)
 
 	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
-	rm.On("IsSynced", ctx, latest).Return(true, nil)
-	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+	rd.On("Delta", latest, latest).Return(delta)
 
 	r, kc, scmd := reconcilerMocks(rmf)
 	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
 
+	// pointers returned from "client.MergeFrom" fails the equality check during
+	// assertion even when parameters inside two objects are same.
+	// hence we use mock.AnythingOfType parameter to assert patch call
 	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
 
 	// With the above mocks and below assertions, we check that if we got a
@@ -1218,20 +1361,22 @@ func TestReconcilerUpdate_ResolveReferencesError(t *testing.T) {
 	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
 	// method,
 	_, err := r.Sync(ctx, rm, desired)
-	require.NotNil(err)
+	require.Nil(err)
 	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
-	rm.AssertNotCalled(t, "ReadOne", ctx, desired)
-	rd.AssertNotCalled(t, "Delta", desired, latest)
+	rm.AssertCalled(t, "ReadOne", ctx, desired)
+	rd.AssertCalled(t, "Delta", desired, latest)
+	// Update is not called because there is no delta
 	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
 	// No changes to metadata or spec so Patch on the object shouldn't be done
 	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
 	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
 	kc.AssertNotCalled(t, "Status")
-	rm.AssertNotCalled(t, "LateInitialize", ctx, latest)
-	rm.AssertNotCalled(t, "EnsureTags", ctx, desired, scmd)
+	rm.AssertCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertCalled(t, "IsSynced", ctx, latest)
+	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
 }
 
-func TestReconcilerUpdate_EnsureControllerTagsError(t *testing.T) {
+func TestReconcilerUpdate_IsSyncedError(t *testing.T) {
 	require := require.New(t)
 
 	ctx := context.TODO()
@@ -1249,12 +1394,8 @@ func TestReconcilerUpdate_EnsureControllerTagsError(t *testing.T) {
 	latest, latestRTObj, _ := resourceMocks()
 	latest.On("Identifiers").Return(ids)
 
-	ensureControllerTagsError := errors.New("failed to ensure controller tags")
+	syncedError := errors.New("rm.IsSynced failed")
 
-	// resourceReconciler.ensureConditions will ensure that if the resource
-	// manager has not set any Conditions on the resource, that at least an
-	// ACK.ResourceSynced condition with status Unknown will be set on the
-	// resource.
 	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
 	latest.On(
 		"ReplaceConditions",
@@ -1264,21 +1405,25 @@ func TestReconcilerUpdate_EnsureControllerTagsError(t *testing.T) {
 		assert.Equal(t, 1, len(conditions))
 		cond := conditions[0]
 		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
-		// The non-terminal reconciler error causes the ResourceSynced
-		// condition to be False
+		// Synced condition is false because rm.IsSynced() method returns
+		// an error
 		assert.Equal(t, corev1.ConditionFalse, cond.Status)
 		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
-		assert.Equal(t, ensureControllerTagsError.Error(), *cond.Reason)
+		assert.Equal(t, syncedError.Error(), *cond.Reason)
 	})
 
 	rm := &ackmocks.AWSResourceManager{}
-	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ResolveReferences", ctx, nil, desired).Return(
+		desired, nil,
+	)
 	rm.On("ReadOne", ctx, desired).Return(
 		latest, nil,
 	)
 	rm.On("Update", ctx, desired, latest, delta).Return(
 		latest, nil,
 	)
+	rm.On("IsSynced", ctx, latest).Return(
+		true, syncedError)
 
 	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
 	rd.On("Delta", desired, latest).Return(
@@ -1287,14 +1432,14 @@ func TestReconcilerUpdate_EnsureControllerTagsError(t *testing.T) {
 	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
 
 	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
-	rm.On("IsSynced", ctx, latest).Return(true, nil)
 	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
 
 	r, kc, scmd := reconcilerMocks(rmf)
-	rm.On("EnsureTags", ctx, desired, scmd).Return(
-		ensureControllerTagsError,
-	)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
 
+	// pointers returned from "client.MergeFrom" fails the equality check during
+	// assertion even when parameters inside two objects are same.
+	// hence we use mock.AnythingOfType parameter to assert patch call
 	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
 
 	// With the above mocks and below assertions, we check that if we got a
@@ -1303,15 +1448,1096 @@ func TestReconcilerUpdate_EnsureControllerTagsError(t *testing.T) {
 	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
 	// method,
 	_, err := r.Sync(ctx, rm, desired)
-	require.NotNil(err)
+	require.Nil(err)
 	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
-	rm.AssertNotCalled(t, "ReadOne", ctx, desired)
-	rd.AssertNotCalled(t, "Delta", desired, latest)
-	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+	rm.AssertCalled(t, "ReadOne", ctx, desired)
+	rd.AssertCalled(t, "Delta", desired, latest)
+	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
 	// No changes to metadata or spec so Patch on the object shouldn't be done
 	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
 	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
 	kc.AssertNotCalled(t, "Status")
-	rm.AssertNotCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertCalled(t, "IsSynced", ctx, latest)
+	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+}
+
+func TestReconcilerUpdate_PatchMetadataAndSpec_DiffInMetadata(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, latestRTObj, latestMetaObj := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return()
+
+	// Note the change in annotations
+	latestMetaObj.SetAnnotations(map[string]string{"a": "b"})
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(
+		delta,
+	).Once()
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(
+		desired, nil,
+	)
+	rm.On("ReadOne", ctx, desired).Return(
+		latest, nil,
+	)
+	rm.On("Update", ctx, desired, latest, delta).Return(
+		latest, nil,
+	)
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+
+	r, kc, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	_, err := r.Sync(ctx, rm, desired)
+	require.Nil(err)
+	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
+	rm.AssertCalled(t, "ReadOne", ctx, desired)
+	rd.AssertCalled(t, "Delta", desired, latest)
+	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
+	kc.AssertCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
+	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
+	kc.AssertNotCalled(t, "Status")
+	rm.AssertCalled(t, "LateInitialize", ctx, latest)
+	latest.AssertCalled(t, "DeepCopy")
+	latest.AssertCalled(t, "SetStatus", latest)
+	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+}
+
+func TestReconcilerUpdate_PatchMetadataAndSpec_DiffInSpec(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, latestRTObj, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		conditions := args.Get(0).([]*ackv1alpha1.Condition)
+		hasSynced := false
+		for _, condition := range conditions {
+			if condition.Type != ackv1alpha1.ConditionTypeResourceSynced {
+				continue
+			}
+
+			hasSynced = true
+			assert.Equal(corev1.ConditionTrue, condition.Status)
+			assert.Equal(ackcondition.SyncedMessage, *condition.Message)
+		}
+		assert.True(hasSynced)
+	})
+	// Note no change to metadata...
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(
+		delta,
+	)
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(
+		desired, nil,
+	)
+	rm.On("ReadOne", ctx, desired).Return(
+		latest, nil,
+	)
+	rm.On("Update", ctx, desired, latest, delta).Return(
+		latest, nil,
+	)
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+
+	r, kc, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	_, err := r.Sync(ctx, rm, desired)
+	require.Nil(err)
+	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
+	rm.AssertCalled(t, "ReadOne", ctx, desired)
+	rd.AssertCalled(t, "Delta", desired, latest)
+	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
+	kc.AssertCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
+	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
+	kc.AssertNotCalled(t, "Status")
+	rm.AssertCalled(t, "LateInitialize", ctx, latest)
 	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
 }
+
+func TestReconcilerHandleReconcilerError_PatchStatus_Latest(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, latestRTObj, latestMetaObj := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return()
+
+	latestMetaObj.SetAnnotations(map[string]string{"a": "b"})
+
+	rmf, _ := managedResourceManagerFactoryMocks(desired, latest)
+	r, kc, _ := reconcilerMocks(rmf)
+
+	statusWriter := &ctrlrtclientmock.SubResourceWriter{}
+	kc.On("Status").Return(statusWriter)
+	statusWriter.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	_, err := r.HandleReconcileError(ctx, desired, latest, nil)
+	require.Nil(err)
+	statusWriter.AssertCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
+	// The HandleReconcilerError function never updates spec or metadata, so
+	// even though there is a change to the annotations we expect no call to
+	// patch the spec/metadata...
+	kc.AssertNotCalled(t, "Patch")
+}
+
+func TestReconcilerHandleReconcilerError_NoPatchStatus_NoLatest(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	rmf, _ := managedResourceManagerFactoryMocks(desired, nil)
+	r, kc, _ := reconcilerMocks(rmf)
+
+	statusWriter := &ctrlrtclientmock.SubResourceWriter{}
+	kc.On("Status").Return(statusWriter)
+
+	_, err := r.HandleReconcileError(ctx, desired, nil, nil)
+	require.Nil(err)
+	// If latest is nil, we should not call patch status...
+	statusWriter.AssertNotCalled(t, "Patch")
+	// The HandleReconcilerError function never updates spec or metadata, so
+	// even though there is a change to the annotations we expect no call to
+	// patch the spec/metadata...
+	kc.AssertNotCalled(t, "Patch")
+}
+
+func TestReconcilerUpdate_ErrorInLateInitialization(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	requeueError := requeue.NeededAfter(errors.New("error from late initialization"), time.Duration(0)*time.Second)
+
+	latest, latestRTObj, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		conditions := args.Get(0).([]*ackv1alpha1.Condition)
+		hasSynced := false
+		for _, condition := range conditions {
+			if condition.Type != ackv1alpha1.ConditionTypeResourceSynced {
+				continue
+			}
+			hasSynced = true
+			// Even though mocked IsSynced method returns (true, nil),
+			// the reconciler error from late initialization correctly causes
+			// the ResourceSynced condition to be Unknown since the reconciler
+			// error is not a Terminal error.
+			assert.Equal(corev1.ConditionUnknown, condition.Status)
+			assert.Equal(ackcondition.UnknownSyncedMessage, *condition.Message)
+			assert.Equal(requeueError.Error(), *condition.Reason)
+		}
+		assert.True(hasSynced)
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(
+		desired, nil,
+	)
+	rm.On("ReadOne", ctx, desired).Return(
+		latest, nil,
+	)
+	rm.On("Update", ctx, desired, latest, delta).Return(
+		latest, nil,
+	)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(
+		delta,
+	).Once()
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
+
+	rm.On("LateInitialize", ctx, latest).Return(latest, requeueError)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+
+	r, kc, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	_, err := r.Sync(ctx, rm, desired)
+	// Assert the error from late initialization
+	require.NotNil(err)
+	assert.Equal(requeueError, err)
+	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
+	rm.AssertCalled(t, "ReadOne", ctx, desired)
+	rd.AssertCalled(t, "Delta", desired, latest)
+	rm.AssertCalled(t, "Update", ctx, desired, latest, delta)
+	// No difference in desired, latest metadata and spec
+	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
+	rm.AssertCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+}
+
+func TestReconcilerUpdate_ResourceNotManaged(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, _, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+
+	terminalCondition := ackv1alpha1.Condition{
+		Type:    ackv1alpha1.ConditionTypeTerminal,
+		Status:  corev1.ConditionTrue,
+		Reason:  &ackcondition.NotManagedReason,
+		Message: &ackcondition.NotManagedMessage,
+	}
+	// Return empty conditions for first two times
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{}).Times(2)
+	// Once the terminal condition is added, return terminal condition
+	// These calls will be made from ensureConditions method, which sets
+	// ACK.ResourceSynced condition correctly
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{&terminalCondition})
+
+	// Verify once when the terminal condition is set
+	latest.On("ReplaceConditions", mock.AnythingOfType("[]*v1alpha1.Condition")).Return([]*ackv1alpha1.Condition{&terminalCondition}).Run(func(args mock.Arguments) {
+		conditions := args.Get(0).([]*ackv1alpha1.Condition)
+		hasTerminal := false
+		for _, condition := range conditions {
+			if condition.Type != ackv1alpha1.ConditionTypeTerminal {
+				continue
+			}
+
+			hasTerminal = true
+			assert.Equal(terminalCondition.Message, condition.Message)
+			assert.Equal(terminalCondition.Reason, condition.Reason)
+		}
+		assert.True(hasTerminal)
+	}).Once()
+
+	// Verify again when ResourceSynced condition is set that both Terminal
+	// and ResourceSynced condition are present
+	latest.On("ReplaceConditions", mock.AnythingOfType("[]*v1alpha1.Condition")).Return([]*ackv1alpha1.Condition{&terminalCondition}).Run(func(args mock.Arguments) {
+		conditions := args.Get(0).([]*ackv1alpha1.Condition)
+		hasTerminal := false
+		for _, condition := range conditions {
+			if condition.Type != ackv1alpha1.ConditionTypeTerminal {
+				continue
+			}
+
+			hasTerminal = true
+			assert.Equal(terminalCondition.Message, condition.Message)
+			assert.Equal(terminalCondition.Reason, condition.Reason)
+		}
+		assert.True(hasTerminal)
+
+		hasSynced := false
+		for _, condition := range conditions {
+			if condition.Type != ackv1alpha1.ConditionTypeResourceSynced {
+				continue
+			}
+			hasSynced = true
+			// The terminal error from reconciler correctly causes
+			// the ResourceSynced condition to be False
+			assert.Equal(corev1.ConditionFalse, condition.Status)
+			assert.Equal(ackcondition.NotSyncedMessage, *condition.Message)
+		}
+		assert.True(hasSynced)
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(
+		desired, nil,
+	)
+	rm.On("ReadOne", ctx, desired).Return(
+		latest, nil,
+	)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+
+	rmf, rd := managerFactoryMocks(desired, latest, false)
+
+	r, _, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	_, err := r.Sync(ctx, rm, desired)
+	require.NotNil(err)
+	assert.Equal(ackerr.Terminal, err)
+	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
+	rm.AssertCalled(t, "ReadOne", ctx, desired)
+	rd.AssertNotCalled(t, "Delta", desired, latest)
+	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+	rm.AssertNotCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+}
+
+func TestReconcilerUpdate_ResolveReferencesError(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, latestRTObj, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+
+	resolveReferenceError := errors.New("failed to resolve reference")
+
+	// resourceReconciler.ensureConditions will ensure that if the resource
+	// manager has not set any Conditions on the resource, that at least an
+	// ACK.ResourceSynced condition with status Unknown will be set on the
+	// resource.
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		conditions := args.Get(0).([]*ackv1alpha1.Condition)
+		assert.Equal(t, 1, len(conditions))
+		cond := conditions[0]
+		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
+		// The non-terminal reconciler error causes the ResourceSynced
+		// condition to be False
+		assert.Equal(t, corev1.ConditionFalse, cond.Status)
+		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
+		assert.Equal(t, resolveReferenceError.Error(), *cond.Reason)
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(
+		nil, resolveReferenceError,
+	)
+	rm.On("ReadOne", ctx, desired).Return(
+		latest, nil,
+	)
+	rm.On("Update", ctx, desired, latest, delta).Return(
+		latest, nil,
+	)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(
+		delta,
+	).Once()
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
+
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+
+	r, kc, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	// With the above mocks and below assertions, we check that if we got a
+	// non-error return from `AWSResourceManager.ReadOne()` and the
+	// `AWSResourceDescriptor.Delta()` returned a non-empty Delta, that we end
+	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
+	// method,
+	_, err := r.Sync(ctx, rm, desired)
+	require.NotNil(err)
+	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
+	rm.AssertNotCalled(t, "ReadOne", ctx, desired)
+	rd.AssertNotCalled(t, "Delta", desired, latest)
+	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+	// No changes to metadata or spec so Patch on the object shouldn't be done
+	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
+	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
+	kc.AssertNotCalled(t, "Status")
+	rm.AssertNotCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertNotCalled(t, "EnsureTags", ctx, desired, scmd)
+}
+
+func TestReconcilerUpdate_EnsureControllerTagsError(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, latestRTObj, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+
+	ensureControllerTagsError := errors.New("failed to ensure controller tags")
+
+	// resourceReconciler.ensureConditions will ensure that if the resource
+	// manager has not set any Conditions on the resource, that at least an
+	// ACK.ResourceSynced condition with status Unknown will be set on the
+	// resource.
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		conditions := args.Get(0).([]*ackv1alpha1.Condition)
+		assert.Equal(t, 1, len(conditions))
+		cond := conditions[0]
+		assert.Equal(t, ackv1alpha1.ConditionTypeResourceSynced, cond.Type)
+		// The non-terminal reconciler error causes the ResourceSynced
+		// condition to be False
+		assert.Equal(t, corev1.ConditionFalse, cond.Status)
+		assert.Equal(t, ackcondition.NotSyncedMessage, *cond.Message)
+		assert.Equal(t, ensureControllerTagsError.Error(), *cond.Reason)
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(
+		latest, nil,
+	)
+	rm.On("Update", ctx, desired, latest, delta).Return(
+		latest, nil,
+	)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(
+		delta,
+	).Once()
+	rd.On("Delta", desired, latest).Return(ackcompare.NewDelta())
+
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+
+	r, kc, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(
+		ensureControllerTagsError,
+	)
+
+	kc.On("Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	// With the above mocks and below assertions, we check that if we got a
+	// non-error return from `AWSResourceManager.ReadOne()` and the
+	// `AWSResourceDescriptor.Delta()` returned a non-empty Delta, that we end
+	// up calling the AWSResourceManager.Update() call in the Reconciler.Sync()
+	// method,
+	_, err := r.Sync(ctx, rm, desired)
+	require.NotNil(err)
+	rm.AssertCalled(t, "ResolveReferences", ctx, nil, desired)
+	rm.AssertNotCalled(t, "ReadOne", ctx, desired)
+	rd.AssertNotCalled(t, "Delta", desired, latest)
+	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+	// No changes to metadata or spec so Patch on the object shouldn't be done
+	kc.AssertNotCalled(t, "Patch", ctx, latestRTObj, mock.AnythingOfType("*client.mergeFromPatch"))
+	// Only the HandleReconcilerError wrapper function ever calls patchResourceStatus
+	kc.AssertNotCalled(t, "Status")
+	rm.AssertNotCalled(t, "LateInitialize", ctx, latest)
+	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+}
+
+func TestRequeueForConditions(t *testing.T) {
+	resyncPeriod := 5 * time.Minute
+	syncedCondition := func(status corev1.ConditionStatus) *ackv1alpha1.Condition {
+		return &ackv1alpha1.Condition{
+			Type:   ackv1alpha1.ConditionTypeResourceSynced,
+			Status: status,
+		}
+	}
+	terminalCondition := &ackv1alpha1.Condition{
+		Type:   ackv1alpha1.ConditionTypeTerminal,
+		Status: corev1.ConditionTrue,
+	}
+
+	tests := []struct {
+		name          string
+		conditions    []*ackv1alpha1.Condition
+		expectRequeue bool
+		expectAfter   time.Duration
+		expectWrapped error
+	}{
+		{"no conditions", nil, false, 0, nil},
+		{"no synced condition", []*ackv1alpha1.Condition{terminalCondition}, false, 0, nil},
+		{"synced true", []*ackv1alpha1.Condition{syncedCondition(corev1.ConditionTrue)}, true, resyncPeriod, nil},
+		{"synced false", []*ackv1alpha1.Condition{syncedCondition(corev1.ConditionFalse)}, true, requeue.DefaultRequeueAfterDuration, ackerr.TemporaryOutOfSync},
+		{"synced unknown", []*ackv1alpha1.Condition{syncedCondition(corev1.ConditionUnknown)}, true, requeue.DefaultRequeueAfterDuration, ackerr.TemporaryOutOfSync},
+		{"first synced condition wins", []*ackv1alpha1.Condition{terminalCondition, syncedCondition(corev1.ConditionTrue), syncedCondition(corev1.ConditionFalse)}, true, resyncPeriod, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			err := ackrt.RequeueForConditions(test.conditions, resyncPeriod)
+			if !test.expectRequeue {
+				require.Nil(err)
+				return
+			}
+			var requeueNeededAfter *requeue.RequeueNeededAfter
+			require.True(errors.As(err, &requeueNeededAfter))
+			require.Equal(test.expectAfter, requeueNeededAfter.Duration())
+			require.Equal(test.expectWrapped, requeueNeededAfter.Unwrap())
+		})
+	}
+}
+
+func TestJitterResyncPeriod(t *testing.T) {
+	require := require.New(t)
+	period := 10 * time.Minute
+
+	require.Equal(period, ackrt.JitterResyncPeriod(period, 0, 0.9))
+	require.Equal(9*time.Minute, ackrt.JitterResyncPeriod(period, 0.1, 0))
+	require.Equal(period, ackrt.JitterResyncPeriod(period, 0.1, 0.5))
+	require.Equal(11*time.Minute, ackrt.JitterResyncPeriod(period, 0.1, 1))
+}
+
+func TestResultForError(t *testing.T) {
+	otherErr := errors.New("other error")
+
+	tests := []struct {
+		name         string
+		err          error
+		expectResult ctrlrt.Result
+		expectErr    error
+	}{
+		{"nil", nil, ctrlrt.Result{}, nil},
+		{"terminal", ackerr.Terminal, ctrlrt.Result{}, nil},
+		{"requeue needed after", requeue.NeededAfter(nil, time.Minute), ctrlrt.Result{RequeueAfter: time.Minute}, nil},
+		{"requeue needed after wrapping error", requeue.NeededAfter(otherErr, time.Second), ctrlrt.Result{RequeueAfter: time.Second}, nil},
+		{"requeue needed", requeue.Needed(nil), ctrlrt.Result{Requeue: true}, nil},
+		{"requeue needed wrapping error", requeue.Needed(otherErr), ctrlrt.Result{Requeue: true}, nil},
+		{"wrapped requeue needed", fmt.Errorf("wrapped: %w", requeue.Needed(nil)), ctrlrt.Result{Requeue: true}, nil},
+		{"other error", otherErr, ctrlrt.Result{}, otherErr},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require := require.New(t)
+			result, err := ackrt.ResultForError(test.err)
+			require.Equal(test.expectResult, result)
+			require.Equal(test.expectErr, err)
+		})
+	}
+}
+
+func TestBackpressureFactor(t *testing.T) {
+	tests := []struct {
+		name       string
+		avgLatency time.Duration
+		threshold  time.Duration
+		expected   float64
+	}{
+		{"disabled", 10 * time.Second, 0, 1},
+		{"below threshold", 100 * time.Millisecond, time.Second, 1},
+		{"above threshold", 3 * time.Second, time.Second, 3},
+		{"capped", time.Minute, time.Second, ackrt.MaxBackpressureFactor},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, ackrt.BackpressureFactor(test.avgLatency, test.threshold))
+		})
+	}
+}
+
+func TestComputeReconcileCounts(t *testing.T) {
+	counts := map[string]string{
+		ackv1alpha1.AnnotationReconcileCount:      "4",
+		ackv1alpha1.AnnotationReconcileErrorCount: "2",
+	}
+	tests := []struct {
+		name                   string
+		annotations            map[string]string
+		synced                 bool
+		err                    error
+		expectedReconcileCount int
+		expectedErrorCount     int
+	}{
+		{"first reconcile, not synced", nil, false, requeue.NeededAfter(ackerr.TemporaryOutOfSync, time.Second), 1, 0},
+		{"first reconcile, failed", nil, false, errors.New("boom"), 1, 1},
+		{"not synced", counts, false, requeue.NeededAfter(ackerr.TemporaryOutOfSync, time.Second), 5, 2},
+		{"failed", counts, false, errors.New("boom"), 5, 3},
+		{"synced", counts, true, requeue.NeededAfter(nil, time.Hour), 0, 0},
+		{"terminal", counts, false, ackerr.Terminal, 0, 0},
+		{"unparseable", map[string]string{ackv1alpha1.AnnotationReconcileCount: "many"}, false, nil, 1, 0},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			reconcileCount, errorCount := ackrt.ComputeReconcileCounts(test.annotations, test.synced, test.err)
+			assert.Equal(t, test.expectedReconcileCount, reconcileCount)
+			assert.Equal(t, test.expectedErrorCount, errorCount)
+		})
+	}
+}
+
+func TestReconcilerSync_MissingRequiredAnnotation(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+
+	desired, _, metaObj := resourceMocks()
+	metaObj.SetAnnotations(map[string]string{"example.com/owner": "team-a"})
+	desired.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+	desired.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		conditions := args.Get(0).([]*ackv1alpha1.Condition)
+		if len(conditions) == 0 {
+			return
+		}
+		cond := conditions[0]
+		assert.Equal(t, ackv1alpha1.ConditionTypeTerminal, cond.Type)
+		assert.Equal(t, corev1.ConditionTrue, cond.Status)
+		assert.Equal(t, "missing required annotation: example.com/cost-center", *cond.Message)
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rmf, _ := managedResourceManagerFactoryMocks(desired, nil)
+	r, _, _ := reconcilerMocksWithConfig(rmf, ackcfg.Config{
+		RequiredAnnotations:        []string{"example.com/owner", "example.com/cost-center"},
+		EnforceRequiredAnnotations: true,
+	})
+
+	_, err := r.Sync(ctx, rm, desired)
+	require.Equal(ackerr.Terminal, err)
+	rm.AssertNotCalled(t, "ResolveReferences", ctx, nil, desired)
+	rm.AssertNotCalled(t, "ReadOne", ctx, desired)
+}
+
+func TestReconcilerUpdate_EnsureControllerTagsError_Tolerated(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+	desired.On("Conditions").Return([]*ackv1alpha1.Condition{
+		{
+			Type:   ackv1alpha1.ConditionTypeTagsApplied,
+			Status: corev1.ConditionFalse,
+		},
+	})
+	desired.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, _, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+
+	ensureControllerTagsError := errors.New("failed to ensure controller tags")
+
+	// Both the ACK.ResourceSynced condition and the ACK.TagsApplied condition
+	// propagated from the desired resource are set on the latest resource.
+	setConditionTypes := []ackv1alpha1.ConditionType{}
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		conditions := args.Get(0).([]*ackv1alpha1.Condition)
+		assert.Equal(t, 1, len(conditions))
+		cond := conditions[0]
+		setConditionTypes = append(setConditionTypes, cond.Type)
+		if cond.Type == ackv1alpha1.ConditionTypeResourceSynced {
+			assert.Equal(t, corev1.ConditionTrue, cond.Status)
+		}
+		if cond.Type == ackv1alpha1.ConditionTypeTagsApplied {
+			assert.Equal(t, corev1.ConditionFalse, cond.Status)
+			assert.Equal(t, ackcondition.TagsNotAppliedMessage, *cond.Message)
+			assert.Equal(t, ensureControllerTagsError.Error(), *cond.Reason)
+		}
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(true, nil)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(delta)
+
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rd.On("Delta", latest, latest).Return(delta)
+
+	r, _, scmd := reconcilerMocksWithConfig(
+		rmf, ackcfg.Config{TolerateTagFailures: true},
+	)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(
+		ensureControllerTagsError,
+	)
+
+	// The tags failure does not prevent the resource from being synced.
+	_, err := r.Sync(ctx, rm, desired)
+	require.Nil(err)
+	rm.AssertCalled(t, "EnsureTags", ctx, desired, scmd)
+	rm.AssertCalled(t, "ReadOne", ctx, desired)
+	rm.AssertCalled(t, "IsSynced", ctx, latest)
+	require.Equal([]ackv1alpha1.ConditionType{
+		ackv1alpha1.ConditionTypeResourceSynced,
+		ackv1alpha1.ConditionTypeTagsApplied,
+	}, setConditionTypes)
+}
+
+func TestReconcilerAdoptedResourceNotFound_Requeue(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+
+	desired, _, desiredMetaObj := resourceMocks()
+	desiredMetaObj.SetAnnotations(map[string]string{
+		ackv1alpha1.AnnotationAdopted: "true",
+	})
+	desired.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	syncedReasons := []string{}
+	desired.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		for _, cond := range args.Get(0).([]*ackv1alpha1.Condition) {
+			if cond.Type == ackv1alpha1.ConditionTypeResourceSynced && cond.Reason != nil {
+				syncedReasons = append(syncedReasons, *cond.Reason)
+			}
+		}
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(nil, ackerr.NotFound)
+	rm.On("IsSynced", ctx, desired).Return(false, nil)
+
+	rmf, _ := managedResourceManagerFactoryMocks(desired, nil)
+	r, _, scmd := reconcilerMocksWithConfig(rmf, ackcfg.Config{
+		RequeueAdoptedResourceNotFound: true,
+		AdoptedNotFoundRequeueSeconds:  30,
+	})
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	// The reconciler waits for the AWS resource to appear instead of failing
+	latest, err := r.Sync(ctx, rm, desired)
+	require.Equal(desired, latest)
+	var requeueNeededAfter *requeue.RequeueNeededAfter
+	require.True(errors.As(err, &requeueNeededAfter))
+	require.Equal(30*time.Second, requeueNeededAfter.Duration())
+	require.ErrorIs(err, ackerr.AdoptedResourceNotFound)
+	rm.AssertNotCalled(t, "Create", ctx, desired)
+	require.NotEmpty(syncedReasons)
+	require.Equal(ackcondition.AdoptedResourceNotFoundReason, syncedReasons[0])
+}
+
+func TestReconcilerExternallyDeleted_Terminal(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	desired, _, _ := resourceMocks()
+	desired.On("Identifiers").Return(ids)
+	desired.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	terminalMessages := []string{}
+	desired.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		for _, cond := range args.Get(0).([]*ackv1alpha1.Condition) {
+			if cond.Type == ackv1alpha1.ConditionTypeTerminal && cond.Message != nil {
+				terminalMessages = append(terminalMessages, *cond.Message)
+			}
+		}
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(nil, ackerr.NotFound)
+	rm.On("IsSynced", ctx, desired).Return(false, nil)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, nil)
+	rd.On("IsManaged", desired).Return(true)
+	r, _, scmd := reconcilerMocksWithConfig(rmf, ackcfg.Config{
+		OnExternalDeletion: ackcfg.OnExternalDeletionTerminal,
+	})
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	// The AWS resource is not created again
+	latest, err := r.Sync(ctx, rm, desired)
+	require.Equal(desired, latest)
+	require.Equal(ackerr.Terminal, err)
+	rm.AssertNotCalled(t, "Create", ctx, desired)
+	require.NotEmpty(terminalMessages)
+	require.Equal(ackcondition.ExternallyDeletedMessage, terminalMessages[0])
+}
+
+func TestReconcilerUpdate_AdoptionObserveFirst(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, desiredMetaObj := resourceMocks()
+	desiredMetaObj.SetAnnotations(map[string]string{
+		ackv1alpha1.AnnotationAdopted: "true",
+	})
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, _, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+
+	setConditions := map[ackv1alpha1.ConditionType]*ackv1alpha1.Condition{}
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		for _, cond := range args.Get(0).([]*ackv1alpha1.Condition) {
+			setConditions[cond.Type] = cond
+		}
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(false, nil)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(delta)
+
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+
+	r, kc, scmd := reconcilerMocksWithConfig(
+		rmf, ackcfg.Config{AdoptionObserveFirst: true},
+	)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+	kc.On("Patch", ctx, mock.Anything, mock.AnythingOfType("*client.mergeFromPatch")).Return(nil)
+
+	// The first reconciliation of the adopted resource only observes it and
+	// marks it as observed.
+	_, err := r.Sync(ctx, rm, desired)
+	require.Nil(err)
+	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+	kc.AssertCalled(t, "Patch", ctx, mock.Anything, mock.AnythingOfType("*client.mergeFromPatch"))
+	require.Equal("true", desiredMetaObj.GetAnnotations()[ackv1alpha1.AnnotationAdoptionObserved])
+	require.NotNil(setConditions[ackv1alpha1.ConditionTypeAdvisory])
+	require.Equal(ackcondition.AdoptionObservedMessage, *setConditions[ackv1alpha1.ConditionTypeAdvisory].Message)
+	require.NotNil(setConditions[ackv1alpha1.ConditionTypeResourceSynced])
+	require.Equal(corev1.ConditionFalse, setConditions[ackv1alpha1.ConditionTypeResourceSynced].Status)
+}
+
+func TestReconcilerUpdate_ApplyAfterFuture(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	applyAfter := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+	desired, _, desiredMetaObj := resourceMocks()
+	desiredMetaObj.SetAnnotations(map[string]string{
+		ackv1alpha1.AnnotationApplyAfter: applyAfter.Format(time.RFC3339),
+	})
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, _, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+
+	setConditions := map[ackv1alpha1.ConditionType]*ackv1alpha1.Condition{}
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		for _, cond := range args.Get(0).([]*ackv1alpha1.Condition) {
+			setConditions[cond.Type] = cond
+		}
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(false, nil)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(delta)
+
+	r, _, scmd := reconcilerMocks(rmf)
+	rm.On("EnsureTags", ctx, desired, scmd).Return(nil)
+
+	// The update is deferred until the scheduled time
+	_, err := r.Sync(ctx, rm, desired)
+	require.NotNil(err)
+	var requeueNeededAfter *requeue.RequeueNeededAfter
+	require.True(errors.As(err, &requeueNeededAfter))
+	require.True(requeueNeededAfter.Duration() > 59*time.Minute)
+	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+	require.NotNil(setConditions[ackv1alpha1.ConditionTypeAdvisory])
+	require.Equal(ackcondition.UpdateScheduledMessage, *setConditions[ackv1alpha1.ConditionTypeAdvisory].Message)
+	require.Equal(
+		"update scheduled for "+applyAfter.Format(time.RFC3339),
+		*setConditions[ackv1alpha1.ConditionTypeAdvisory].Reason,
+	)
+}
+
+func TestReconcilerUpdate_ReadOnlyMode(t *testing.T) {
+	require := require.New(t)
+
+	ctx := context.TODO()
+	arn := ackv1alpha1.AWSResourceName("mybook-arn")
+
+	delta := ackcompare.NewDelta()
+	delta.Add("Spec.A", "val1", "val2")
+
+	desired, _, _ := resourceMocks()
+	desired.On("ReplaceConditions", []*ackv1alpha1.Condition{}).Return()
+
+	ids := &ackmocks.AWSResourceIdentifiers{}
+	ids.On("ARN").Return(&arn)
+
+	latest, _, _ := resourceMocks()
+	latest.On("Identifiers").Return(ids)
+
+	// Conditions are not persisted by the mocks, so ensureConditions sets
+	// the ACK.ResourceSynced condition again once Sync returns.
+	syncedReasons := []string{}
+	latest.On("Conditions").Return([]*ackv1alpha1.Condition{})
+	latest.On(
+		"ReplaceConditions",
+		mock.AnythingOfType("[]*v1alpha1.Condition"),
+	).Return().Run(func(args mock.Arguments) {
+		for _, cond := range args.Get(0).([]*ackv1alpha1.Condition) {
+			if cond.Type == ackv1alpha1.ConditionTypeResourceSynced && cond.Reason != nil {
+				require.Equal(corev1.ConditionFalse, cond.Status)
+				syncedReasons = append(syncedReasons, *cond.Reason)
+			}
+		}
+	})
+
+	rm := &ackmocks.AWSResourceManager{}
+	rm.On("ResolveReferences", ctx, nil, desired).Return(desired, nil)
+	rm.On("ReadOne", ctx, desired).Return(latest, nil)
+	rm.On("IsSynced", ctx, latest).Return(false, nil)
+
+	rmf, rd := managedResourceManagerFactoryMocks(desired, latest)
+	rd.On("Delta", desired, latest).Return(delta)
+
+	rm.On("LateInitialize", ctx, latest).Return(latest, nil)
+	rd.On("Delta", latest, latest).Return(ackcompare.NewDelta())
+
+	r, _, scmd := reconcilerMocksWithConfig(
+		rmf, ackcfg.Config{ReadOnlyMode: true},
+	)
+
+	// The drift is reported instead of updating the AWS resource
+	_, err := r.Sync(ctx, rm, desired)
+	require.Nil(err)
+	rm.AssertNotCalled(t, "Update", ctx, desired, latest, delta)
+	rm.AssertNotCalled(t, "EnsureTags", ctx, desired, scmd)
+	require.NotEmpty(syncedReasons)
+	require.Contains(syncedReasons[0], "read-only mode")
+	require.Contains(syncedReasons[0], "Spec.A")
+}
@@ -0,0 +1,136 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// referenceNodeKey returns the string identifying a resource in the reference
+// graph, e.g. "Function.lambda.services.k8s.aws/default/my-function"
+func referenceNodeKey(gk metav1.GroupKind, namespace string, name string) string {
+	return fmt.Sprintf("%s/%s/%s", gk.String(), namespace, name)
+}
+
+// findReferenceCycle walks the graph of resources referenced from the supplied
+// resource and returns the chain of resources leading back to the supplied
+// resource, if any. It returns nil if the resource is not part of a reference
+// cycle.
+//
+// Only resources whose descriptors implement
+// acktypes.AWSResourceReferenceDescriptor and whose kinds are managed by this
+// service controller can be walked. Referenced resources that do not exist
+// yet are skipped.
+func (r *resourceReconciler) findReferenceCycle(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) ([]string, error) {
+	if _, ok := r.rd.(acktypes.AWSResourceReferenceDescriptor); !ok {
+		return nil, nil
+	}
+	rmfs := r.sc.GetResourceManagerFactories()
+	start := referenceNodeKey(
+		*r.rd.GroupKind(),
+		res.MetaObject().GetNamespace(),
+		res.MetaObject().GetName(),
+	)
+	visited := map[string]bool{start: true}
+	path := []string{start}
+
+	var visit func(acktypes.AWSResourceDescriptor, acktypes.AWSResource) ([]string, error)
+	visit = func(
+		rd acktypes.AWSResourceDescriptor,
+		res acktypes.AWSResource,
+	) ([]string, error) {
+		refDescriptor, ok := rd.(acktypes.AWSResourceReferenceDescriptor)
+		if !ok {
+			return nil, nil
+		}
+		for _, ref := range refDescriptor.ReferencedResources(res) {
+			namespace := ref.Namespace
+			if namespace == "" {
+				namespace = res.MetaObject().GetNamespace()
+			}
+			key := referenceNodeKey(ref.GroupKind, namespace, ref.Name)
+			if key == start {
+				cycle := append([]string{}, path...)
+				return append(cycle, key), nil
+			}
+			if visited[key] {
+				continue
+			}
+			visited[key] = true
+
+			rmf, ok := rmfs[ref.GroupKind.String()]
+			if !ok {
+				continue
+			}
+			refRD := rmf.ResourceDescriptor()
+			obj := refRD.EmptyRuntimeObject()
+			nsn := client.ObjectKey{Namespace: namespace, Name: ref.Name}
+			if err := r.apiReader.Get(ctx, nsn, obj); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			path = append(path, key)
+			cycle, err := visit(refRD, refRD.ResourceFromRuntimeObject(obj))
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+			path = path[:len(path)-1]
+		}
+		return nil, nil
+	}
+	return visit(r.rd, res)
+}
+
+// failOnReferenceCycle ensures that the supplied resource is not part of a
+// reference cycle. Resources in a reference cycle can never have their
+// references resolved, so instead of requeueing them forever, this method
+// sets an ACK.Terminal condition naming the cycle and returns a Terminal
+// error.
+func (r *resourceReconciler) failOnReferenceCycle(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) error {
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("r.failOnReferenceCycle")
+	defer func() {
+		exit(err)
+	}()
+
+	cycle, err := r.findReferenceCycle(ctx, res)
+	if err != nil || cycle == nil {
+		return err
+	}
+	msg := ackerr.ResourceReferenceCycleFor(cycle...).Error()
+	ackcondition.SetReferencesResolved(res, corev1.ConditionFalse, &msg, nil)
+	ackcondition.SetTerminal(res, corev1.ConditionTrue, &msg, nil)
+	rlog.Info("detected resource reference cycle", "cycle", cycle)
+	return ackerr.Terminal
+}
@@ -0,0 +1,119 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// countingReader wraps a client.Reader and counts the Get and List calls made
+// through it. Once more than limit calls have been made, further calls fail
+// with an ackerr.TooManyReferences error without reaching the Kubernetes API
+// server. A limit of 0 means no limit.
+type countingReader struct {
+	client.Reader
+	limit int
+	reads int
+}
+
+// NewCountingReader returns a client.Reader that counts the reads made through
+// the supplied reader, failing with ackerr.TooManyReferences once more than
+// limit reads are made. The returned function reports the number of reads
+// attempted so far.
+func NewCountingReader(
+	reader client.Reader,
+	limit int,
+) (client.Reader, func() int) {
+	cr := &countingReader{Reader: reader, limit: limit}
+	return cr, func() int { return cr.reads }
+}
+
+// Get implements client.Reader
+func (c *countingReader) Get(
+	ctx context.Context,
+	key client.ObjectKey,
+	obj client.Object,
+	opts ...client.GetOption,
+) error {
+	if err := c.count(); err != nil {
+		return err
+	}
+	return c.Reader.Get(ctx, key, obj, opts...)
+}
+
+// List implements client.Reader
+func (c *countingReader) List(
+	ctx context.Context,
+	list client.ObjectList,
+	opts ...client.ListOption,
+) error {
+	if err := c.count(); err != nil {
+		return err
+	}
+	return c.Reader.List(ctx, list, opts...)
+}
+
+// count records a read and returns an error if the read exceeds the limit
+func (c *countingReader) count() error {
+	c.reads++
+	if c.limit > 0 && c.reads > c.limit {
+		return ackerr.TooManyReferencesFor(c.limit)
+	}
+	return nil
+}
+
+// resolveReferences resolves the references of the supplied resource while
+// counting the referenced resources read from the Kubernetes API server. The
+// number of reads is recorded in the ack_reference_reads_per_reconcile
+// metric. When resolving the references requires more reads than allowed by
+// the --max-reference-reads-per-reconcile flag, the resource's
+// ACK.ReferencesResolved condition is set to False, an ACK.Terminal condition
+// is set and a Terminal error is returned.
+func (r *resourceReconciler) resolveReferences(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, error) {
+	if r.apiReader == nil {
+		return rm.ResolveReferences(ctx, r.apiReader, res)
+	}
+	reader, reads := NewCountingReader(r.apiReader, r.cfg.MaxReferenceReads)
+	resolved, err := rm.ResolveReferences(ctx, reader, res)
+	if r.metrics != nil {
+		r.metrics.RecordReferenceReads(r.rd.GroupKind().Kind, reads())
+	}
+	if err == nil || !errors.Is(err, ackerr.TooManyReferences) {
+		return resolved, err
+	}
+	if resolved == nil {
+		resolved = res
+	}
+	msg := err.Error()
+	ackcondition.SetReferencesResolved(resolved, corev1.ConditionFalse, &msg, nil)
+	ackcondition.SetTerminal(resolved, corev1.ConditionTrue, &msg, nil)
+	ackrtlog.FromContext(ctx).Info(
+		"too many references to resolve",
+		"limit", r.cfg.MaxReferenceReads,
+	)
+	return resolved, ackerr.Terminal
+}
@@ -0,0 +1,55 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	ctrlrtclientmock "github.com/aws-controllers-k8s/runtime/mocks/controller-runtime/pkg/client"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestCountingReader(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+	ctx := context.TODO()
+	key := types.NamespacedName{Namespace: "default", Name: "ref"}
+
+	apiReader := &ctrlrtclientmock.Reader{}
+	apiReader.On("Get", ctx, key, mock.Anything).Return(nil)
+
+	reader, reads := ackrt.NewCountingReader(apiReader, 2)
+	require.Nil(reader.Get(ctx, key, nil))
+	require.Nil(reader.Get(ctx, key, nil))
+	err := reader.Get(ctx, key, nil)
+	require.NotNil(err)
+	assert.True(errors.Is(err, ackerr.TooManyReferences))
+	assert.Equal(3, reads())
+	apiReader.AssertNumberOfCalls(t, "Get", 2)
+
+	// A limit of 0 means no limit
+	reader, reads = ackrt.NewCountingReader(apiReader, 0)
+	for i := 0; i < 5; i++ {
+		require.Nil(reader.Get(ctx, key, nil))
+	}
+	assert.Equal(5, reads())
+}
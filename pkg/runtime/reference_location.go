@@ -0,0 +1,115 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// CheckReferenceLocation returns an error if the referenced resource
+// identified by the supplied key is in a different AWS account or region than
+// the referring resource. Empty accounts or regions are not compared.
+func CheckReferenceLocation(
+	reference string,
+	account ackv1alpha1.AWSAccountID,
+	region ackv1alpha1.AWSRegion,
+	expectedAccount ackv1alpha1.AWSAccountID,
+	expectedRegion ackv1alpha1.AWSRegion,
+) error {
+	if account != "" && expectedAccount != "" && account != expectedAccount {
+		return ackerr.ResourceReferenceDifferentAccountFor(
+			reference, string(account), string(expectedAccount),
+		)
+	}
+	if region != "" && expectedRegion != "" && region != expectedRegion {
+		return ackerr.ResourceReferenceDifferentRegionFor(
+			reference, string(region), string(expectedRegion),
+		)
+	}
+	return nil
+}
+
+// failOnReferenceLocationMismatch ensures that the resources directly
+// referenced by the supplied resource are in the same AWS account and region
+// as the resource. References across accounts or regions are usually
+// misconfigurations that lead to confusing AWS errors, so this method sets an
+// ACK.Terminal condition naming the offending reference and returns a
+// Terminal error instead.
+//
+// Only references to resources whose kinds are managed by this service
+// controller can be checked. Referenced resources that do not exist yet are
+// skipped.
+func (r *resourceReconciler) failOnReferenceLocationMismatch(
+	ctx context.Context,
+	res acktypes.AWSResource,
+) error {
+	refDescriptor, ok := r.rd.(acktypes.AWSResourceReferenceDescriptor)
+	if !ok {
+		return nil
+	}
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("r.failOnReferenceLocationMismatch")
+	defer func() {
+		exit(err)
+	}()
+
+	rmfs := r.sc.GetResourceManagerFactories()
+	account := r.getOwnerAccountID(res)
+	region := r.getRegion(res)
+	for _, ref := range refDescriptor.ReferencedResources(res) {
+		rmf, ok := rmfs[ref.GroupKind.String()]
+		if !ok {
+			continue
+		}
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = res.MetaObject().GetNamespace()
+		}
+		refRD := rmf.ResourceDescriptor()
+		obj := refRD.EmptyRuntimeObject()
+		nsn := client.ObjectKey{Namespace: namespace, Name: ref.Name}
+		if err = r.apiReader.Get(ctx, nsn, obj); err != nil {
+			if apierrors.IsNotFound(err) {
+				err = nil
+				continue
+			}
+			return err
+		}
+		refRes := refRD.ResourceFromRuntimeObject(obj)
+		mismatch := CheckReferenceLocation(
+			referenceNodeKey(ref.GroupKind, namespace, ref.Name),
+			r.getOwnerAccountID(refRes), r.getRegion(refRes),
+			account, region,
+		)
+		if mismatch != nil {
+			msg := mismatch.Error()
+			ackcondition.SetReferencesResolved(res, corev1.ConditionFalse, &msg, nil)
+			ackcondition.SetTerminal(res, corev1.ConditionTrue, &msg, nil)
+			rlog.Info("referenced resource is in a different location", "error", msg)
+			return ackerr.Terminal
+		}
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestCheckReferenceLocation(t *testing.T) {
+	require := require.New(t)
+	ref := "Key.kms.services.k8s.aws/default/my-key"
+	account := ackv1alpha1.AWSAccountID("111111111111")
+	region := ackv1alpha1.AWSRegion("us-west-2")
+
+	require.Nil(ackrt.CheckReferenceLocation(ref, account, region, account, region))
+	// Unknown locations are not compared
+	require.Nil(ackrt.CheckReferenceLocation(ref, "", "", account, region))
+
+	err := ackrt.CheckReferenceLocation(ref, "222222222222", region, account, region)
+	require.True(errors.Is(err, ackerr.ResourceReferenceDifferentAccount))
+	require.Contains(err.Error(), ref)
+
+	err = ackrt.CheckReferenceLocation(ref, account, "us-east-1", account, region)
+	require.True(errors.Is(err, ackerr.ResourceReferenceDifferentRegion))
+	require.Contains(err.Error(), "region:us-east-1")
+}
@@ -0,0 +1,61 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// failOnReferenceMismatch checks, with resource managers implementing
+// AWSResourceReferenceValidator, that the supplied resource with resolved
+// references is consistent with the resources it references. Mismatches are
+// misconfigurations that the AWS APIs usually reject with cryptic errors, so
+// this method sets an ACK.Terminal condition describing the mismatch and
+// returns a Terminal error instead.
+func (r *resourceReconciler) failOnReferenceMismatch(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	res acktypes.AWSResource,
+) error {
+	validator, ok := rm.(acktypes.AWSResourceReferenceValidator)
+	if !ok {
+		return nil
+	}
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("r.failOnReferenceMismatch")
+	defer func() {
+		exit(err)
+	}()
+
+	rlog.Enter("rm.ValidateReferences")
+	err = validator.ValidateReferences(ctx, r.apiReader, res)
+	rlog.Exit("rm.ValidateReferences", err)
+	if !errors.Is(err, ackerr.ResourceReferenceMismatch) {
+		return err
+	}
+	msg := err.Error()
+	ackcondition.SetReferencesResolved(res, corev1.ConditionFalse, &msg, nil)
+	ackcondition.SetTerminal(res, corev1.ConditionTrue, &msg, nil)
+	rlog.Info("referenced resource does not match", "error", msg)
+	return ackerr.Terminal
+}
@@ -0,0 +1,238 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// referenceIndex keeps track of the resources referred to by the resources
+// of a reconciler, so that referring resources can be reconciled again when
+// the resources they reference change.
+type referenceIndex struct {
+	sync.RWMutex
+	// referrers maps the node keys of referenced resources to the referring
+	// resources and the fields of the referenced resource they depend on
+	referrers map[string]map[k8stypes.NamespacedName][]string
+	// references maps referring resources to the node keys of the resources
+	// they reference
+	references map[k8stypes.NamespacedName][]string
+}
+
+// newReferenceIndex returns an empty referenceIndex
+func newReferenceIndex() *referenceIndex {
+	return &referenceIndex{
+		referrers:  map[string]map[k8stypes.NamespacedName][]string{},
+		references: map[k8stypes.NamespacedName][]string{},
+	}
+}
+
+// set replaces the resources referred to by the supplied referrer.
+func (i *referenceIndex) set(
+	referrer k8stypes.NamespacedName,
+	refs []acktypes.ReferencedResource,
+) {
+	i.Lock()
+	defer i.Unlock()
+	i.removeLocked(referrer)
+	keys := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		namespace := ref.Namespace
+		if namespace == "" {
+			namespace = referrer.Namespace
+		}
+		key := referenceNodeKey(ref.GroupKind, namespace, ref.Name)
+		if _, ok := i.referrers[key]; !ok {
+			i.referrers[key] = map[k8stypes.NamespacedName][]string{}
+		}
+		i.referrers[key][referrer] = ref.Fields
+		keys = append(keys, key)
+	}
+	if len(keys) > 0 {
+		i.references[referrer] = keys
+	}
+}
+
+// add records that the supplied referrer refers to the resource with the
+// supplied node key, in addition to the resources it already refers to.
+func (i *referenceIndex) add(
+	referrer k8stypes.NamespacedName,
+	key string,
+	fields []string,
+) {
+	i.Lock()
+	defer i.Unlock()
+	if _, ok := i.referrers[key]; !ok {
+		i.referrers[key] = map[k8stypes.NamespacedName][]string{}
+	}
+	if _, ok := i.referrers[key][referrer]; !ok {
+		i.references[referrer] = append(i.references[referrer], key)
+	}
+	i.referrers[key][referrer] = fields
+}
+
+// remove forgets about the resources referred to by the supplied referrer.
+func (i *referenceIndex) remove(referrer k8stypes.NamespacedName) {
+	i.Lock()
+	defer i.Unlock()
+	i.removeLocked(referrer)
+}
+
+func (i *referenceIndex) removeLocked(referrer k8stypes.NamespacedName) {
+	for _, key := range i.references[referrer] {
+		delete(i.referrers[key], referrer)
+		if len(i.referrers[key]) == 0 {
+			delete(i.referrers, key)
+		}
+	}
+	delete(i.references, referrer)
+}
+
+// referrersOf returns the resources referring to the resource with the
+// supplied node key, along with the fields they depend on.
+func (i *referenceIndex) referrersOf(key string) map[k8stypes.NamespacedName][]string {
+	i.RLock()
+	defer i.RUnlock()
+	referrers := make(map[k8stypes.NamespacedName][]string, len(i.referrers[key]))
+	for referrer, fields := range i.referrers[key] {
+		referrers[referrer] = fields
+	}
+	return referrers
+}
+
+// indexReferences records the resources referred to by the supplied
+// resource, if the reconciler's resource descriptor exposes them.
+func (r *resourceReconciler) indexReferences(res acktypes.AWSResource) {
+	refDescriptor, ok := r.rd.(acktypes.AWSResourceReferenceDescriptor)
+	if !ok || r.references == nil {
+		return
+	}
+	referrer := k8stypes.NamespacedName{
+		Namespace: res.MetaObject().GetNamespace(),
+		Name:      res.MetaObject().GetName(),
+	}
+	r.references.set(referrer, refDescriptor.ReferencedResources(res))
+}
+
+// ReferencedFieldsChanged returns true if any of the supplied fields, in
+// dotted JSON notation, differs between the old and new versions of a
+// referenced object. It returns true when no fields are supplied, since the
+// referring resource then depends on the whole referenced object.
+func ReferencedFieldsChanged(
+	oldObj client.Object,
+	newObj client.Object,
+	fields []string,
+) bool {
+	if len(fields) == 0 {
+		return true
+	}
+	oldContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(oldObj)
+	if err != nil {
+		return true
+	}
+	newContent, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(newObj)
+	if err != nil {
+		return true
+	}
+	for _, field := range fields {
+		path := strings.Split(field, ".")
+		oldValue, _, _ := unstructured.NestedFieldNoCopy(oldContent, path...)
+		newValue, _, _ := unstructured.NestedFieldNoCopy(newContent, path...)
+		if !reflect.DeepEqual(oldValue, newValue) {
+			return true
+		}
+	}
+	return false
+}
+
+// referenceEventHandler enqueues the resources referring to a referenced
+// resource of a specific kind when the referenced resource changes.
+type referenceEventHandler struct {
+	groupKind metav1.GroupKind
+	index     *referenceIndex
+	// resyncs is the resync tracker of the referrers' reconciler. The
+	// resyncs recorded for enqueued referrers are forgotten so that their
+	// reconciliations are not mistaken for redundant resyncs.
+	resyncs *resyncTracker
+}
+
+var _ handler.EventHandler = &referenceEventHandler{}
+
+// Create enqueues all the referrers of the created resource, since they may
+// have been waiting for it to exist.
+func (h *referenceEventHandler) Create(
+	evt event.CreateEvent,
+	q workqueue.RateLimitingInterface,
+) {
+	h.enqueue(evt.Object, q, func([]string) bool { return true })
+}
+
+// Update enqueues the referrers of the updated resource that depend on one of
+// the fields that changed.
+func (h *referenceEventHandler) Update(
+	evt event.UpdateEvent,
+	q workqueue.RateLimitingInterface,
+) {
+	h.enqueue(evt.ObjectNew, q, func(fields []string) bool {
+		return ReferencedFieldsChanged(evt.ObjectOld, evt.ObjectNew, fields)
+	})
+}
+
+// Delete enqueues all the referrers of the deleted resource.
+func (h *referenceEventHandler) Delete(
+	evt event.DeleteEvent,
+	q workqueue.RateLimitingInterface,
+) {
+	h.enqueue(evt.Object, q, func([]string) bool { return true })
+}
+
+// Generic does nothing.
+func (h *referenceEventHandler) Generic(
+	event.GenericEvent,
+	workqueue.RateLimitingInterface,
+) {
+}
+
+func (h *referenceEventHandler) enqueue(
+	obj client.Object,
+	q workqueue.RateLimitingInterface,
+	shouldEnqueue func(fields []string) bool,
+) {
+	if obj == nil {
+		return
+	}
+	key := referenceNodeKey(h.groupKind, obj.GetNamespace(), obj.GetName())
+	for referrer, fields := range h.index.referrersOf(key) {
+		if shouldEnqueue(fields) {
+			if h.resyncs != nil {
+				h.resyncs.forget(referrer)
+			}
+			q.Add(reconcile.Request{NamespacedName: referrer})
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sobj "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestReferencedFieldsChanged(t *testing.T) {
+	assert := assert.New(t)
+
+	oldObj := &k8sobj.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{"description": "old"},
+		"status": map[string]interface{}{
+			"ackResourceMetadata": map[string]interface{}{"arn": "arn:aws:sns:us-west-2:123456789012:topic"},
+		},
+	}}
+	newObj := oldObj.DeepCopy()
+	newObj.Object["spec"] = map[string]interface{}{"description": "new"}
+
+	assert.True(ackrt.ReferencedFieldsChanged(oldObj, newObj, nil))
+	assert.True(ackrt.ReferencedFieldsChanged(oldObj, newObj, []string{"spec.description"}))
+	assert.False(ackrt.ReferencedFieldsChanged(oldObj, newObj, []string{"status.ackResourceMetadata.arn"}))
+	assert.False(ackrt.ReferencedFieldsChanged(oldObj, newObj, []string{"status.missing"}))
+
+	newObj.Object["status"] = map[string]interface{}{}
+	assert.True(ackrt.ReferencedFieldsChanged(oldObj, newObj, []string{"status.ackResourceMetadata.arn"}))
+}
+
+func TestReferencedFieldsChanged_SecretData(t *testing.T) {
+	assert := assert.New(t)
+
+	oldSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db-credentials", ResourceVersion: "1"},
+		Data:       map[string][]byte{"password": []byte("old")},
+	}
+	newSecret := oldSecret.DeepCopy()
+	newSecret.ResourceVersion = "2"
+	newSecret.Labels = map[string]string{"rotated": "false"}
+
+	// Resources reading a Secret only depend on its data
+	assert.False(ackrt.ReferencedFieldsChanged(oldSecret, newSecret, []string{"data"}))
+
+	newSecret.Data["password"] = []byte("new")
+	assert.True(ackrt.ReferencedFieldsChanged(oldSecret, newSecret, []string{"data"}))
+}
@@ -0,0 +1,141 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// RequiredFieldViolations returns the sorted paths, in dotted JSON notation,
+// of the supplied required field values that the supplied object does not
+// have. Field values are compared with the required values using their string
+// representation, e.g. "true" for a boolean field.
+func RequiredFieldViolations(
+	obj client.Object,
+	required map[string]string,
+) ([]string, error) {
+	content, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	violations := []string{}
+	for path, want := range required {
+		value, found, err := unstructured.NestedFieldNoCopy(content, strings.Split(path, ".")...)
+		if err != nil || !found || fmt.Sprint(value) != want {
+			violations = append(violations, path)
+		}
+	}
+	sort.Strings(violations)
+	return violations, nil
+}
+
+// SetRequiredFieldValues sets, in place, the fields of the supplied object
+// found at the supplied paths to their required values. Required values of
+// "true" and "false" are set as booleans and integer values as integers.
+func SetRequiredFieldValues(
+	obj client.Object,
+	required map[string]string,
+	paths []string,
+) error {
+	content, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return err
+	}
+	for _, path := range paths {
+		var value interface{} = required[path]
+		if b, err := strconv.ParseBool(required[path]); err == nil {
+			value = b
+		} else if i, err := strconv.ParseInt(required[path], 10, 64); err == nil {
+			value = i
+		}
+		if err := unstructured.SetNestedField(content, value, strings.Split(path, ".")...); err != nil {
+			return err
+		}
+	}
+	return k8sruntime.DefaultUnstructuredConverter.FromUnstructured(content, obj)
+}
+
+// requiredFieldValues returns the field values required for the AWS resources
+// of the supplied kind by the resource descriptor and the
+// --required-field-values flag, the latter taking precedence.
+func requiredFieldValues(
+	rd acktypes.AWSResourceDescriptor,
+	configured map[string]map[string]string,
+) map[string]string {
+	required := map[string]string{}
+	if descriptor, ok := rd.(acktypes.AWSResourceRequiredFieldDescriptor); ok {
+		for path, value := range descriptor.RequiredFieldValues() {
+			required[path] = value
+		}
+	}
+	for path, value := range configured[strings.ToLower(rd.GroupKind().Kind)] {
+		required[path] = value
+	}
+	return required
+}
+
+// checkRequiredFields ensures that the supplied latest observed resource has
+// the required field values.
+//
+// With the "enforce" --required-fields-policy, when all missing values are
+// Spec fields, they are set on the supplied desired resource so that the
+// following update applies them to the AWS resource. Otherwise an
+// ACK.Terminal condition listing the missing values is set on the latest
+// resource and a Terminal error is returned.
+func (r *resourceReconciler) checkRequiredFields(
+	ctx context.Context,
+	desired acktypes.AWSResource,
+	latest acktypes.AWSResource,
+) error {
+	if len(r.requiredFields) == 0 {
+		return nil
+	}
+	violations, err := RequiredFieldViolations(latest.RuntimeObject(), r.requiredFields)
+	if err != nil || len(violations) == 0 {
+		return err
+	}
+	if r.cfg.RequiredFieldsPolicy == ackcfg.RequiredFieldsPolicyEnforce && allSpecPaths(violations) {
+		Explain(ctx, explainStepUpdate, "enforcing required field values %s", strings.Join(violations, ", "))
+		ackrtlog.FromContext(ctx).Info("enforcing required field values", "fields", violations)
+		return SetRequiredFieldValues(desired.RuntimeObject(), r.requiredFields, violations)
+	}
+	msg := ackerr.RequiredFieldValueViolationFor(violations...).Error()
+	ackcondition.SetTerminal(latest, corev1.ConditionTrue, &msg, nil)
+	return ackerr.Terminal
+}
+
+// allSpecPaths returns true if all the supplied paths are Spec field paths
+func allSpecPaths(paths []string) bool {
+	for _, path := range paths {
+		if !strings.HasPrefix(path, "spec.") {
+			return false
+		}
+	}
+	return true
+}
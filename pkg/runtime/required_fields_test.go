@@ -0,0 +1,56 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8sobj "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestRequiredFieldViolations(t *testing.T) {
+	require := require.New(t)
+	required := map[string]string{
+		"spec.encryption.enabled": "true",
+		"spec.encryption.keySize": "256",
+		"status.kmsKeyID":         "alias/acme",
+	}
+
+	latest := &k8sobj.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "s3.services.k8s.aws/v1alpha1",
+		"kind":       "Bucket",
+		"spec": map[string]interface{}{
+			"encryption": map[string]interface{}{
+				"enabled": false,
+				"keySize": int64(256),
+			},
+		},
+	}}
+	violations, err := ackrt.RequiredFieldViolations(latest, required)
+	require.Nil(err)
+	require.Equal([]string{"spec.encryption.enabled", "status.kmsKeyID"}, violations)
+
+	require.Nil(ackrt.SetRequiredFieldValues(latest, required, violations))
+	enabled, _, _ := k8sobj.NestedBool(latest.Object, "spec", "encryption", "enabled")
+	require.True(enabled)
+	keyID, _, _ := k8sobj.NestedString(latest.Object, "status", "kmsKeyID")
+	require.Equal("alias/acme", keyID)
+
+	violations, err = ackrt.RequiredFieldViolations(latest, required)
+	require.Nil(err)
+	require.Empty(violations)
+}
@@ -0,0 +1,59 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// ResourceLoggerFieldValues returns the key/value pairs, ready to be passed to
+// logr.Logger.WithValues, of the supplied logger fields whose label or
+// annotation is set on the supplied resource. Fields whose label or
+// annotation is not set are omitted.
+func ResourceLoggerFieldValues(
+	res acktypes.AWSResource,
+	fields []ackcfg.ResourceLoggerField,
+) []interface{} {
+	values := []interface{}{}
+	mo := res.MetaObject()
+	for _, field := range fields {
+		var source map[string]string
+		switch field.Source {
+		case ackcfg.ResourceLoggerFieldSourceLabel:
+			source = mo.GetLabels()
+		case ackcfg.ResourceLoggerFieldSourceAnnotation:
+			source = mo.GetAnnotations()
+		}
+		if value, ok := source[field.Key]; ok {
+			values = append(values, field.Name, value)
+		}
+	}
+	return values
+}
+
+// resourceLoggerFields returns the custom key/value pairs added to the logger
+// of the supplied resource. These are the fields configured with the
+// --resource-logger-fields flag followed by the fields returned by the
+// resource manager factory, when it implements
+// acktypes.ResourceLoggerFieldsExtractor.
+func (r *resourceReconciler) resourceLoggerFields(
+	res acktypes.AWSResource,
+) []interface{} {
+	values := ResourceLoggerFieldValues(res, r.loggerFields)
+	if extractor, ok := r.rmf.(acktypes.ResourceLoggerFieldsExtractor); ok {
+		values = append(values, extractor.ResourceLoggerFields(res)...)
+	}
+	return values
+}
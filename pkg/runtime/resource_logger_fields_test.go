@@ -0,0 +1,42 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestResourceLoggerFieldValues(t *testing.T) {
+	res, _, metaObj := resourceMocks()
+	metaObj.SetLabels(map[string]string{"example.com/business-unit": "payments"})
+	metaObj.SetAnnotations(map[string]string{"example.com/owner": "team-a"})
+
+	fields := []ackcfg.ResourceLoggerField{
+		{Source: ackcfg.ResourceLoggerFieldSourceLabel, Key: "example.com/business-unit", Name: "business_unit"},
+		{Source: ackcfg.ResourceLoggerFieldSourceAnnotation, Key: "example.com/owner", Name: "owner"},
+		// Missing labels are omitted
+		{Source: ackcfg.ResourceLoggerFieldSourceLabel, Key: "example.com/cost-center", Name: "cost_center"},
+		// Labels and annotations are not interchangeable
+		{Source: ackcfg.ResourceLoggerFieldSourceAnnotation, Key: "example.com/business-unit", Name: "bu"},
+	}
+	assert.Equal(t,
+		[]interface{}{"business_unit", "payments", "owner", "team-a"},
+		ackrt.ResourceLoggerFieldValues(res, fields),
+	)
+}
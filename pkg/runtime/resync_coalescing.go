@@ -0,0 +1,95 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"sync"
+	"time"
+
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+// resyncCoalescingTolerance is how early before its due time a resync is
+// still considered to be the expected resync rather than a redundant one.
+const resyncCoalescingTolerance = time.Second
+
+// resyncTracker keeps track, for each resource, of the time at which the
+// latest successful reconciliation scheduled the resource's next resync.
+//
+// A resource may be enqueued by the resync timer of an earlier
+// reconciliation shortly after a reconciliation triggered by a generation
+// change already brought it in sync. The tracker allows recognizing such
+// redundant reconciliations, which would otherwise result in back-to-back
+// reconciliations and wasted AWS reads.
+type resyncTracker struct {
+	sync.Mutex
+	entries map[k8stypes.NamespacedName]resyncEntry
+}
+
+// resyncEntry records the next resync scheduled for a resource
+type resyncEntry struct {
+	// generation is the generation of the resource that was reconciled
+	generation int64
+	// due is the time at which the resource is scheduled to be resynced
+	due time.Time
+}
+
+// newResyncTracker returns a new, empty resyncTracker
+func newResyncTracker() *resyncTracker {
+	return &resyncTracker{
+		entries: map[k8stypes.NamespacedName]resyncEntry{},
+	}
+}
+
+// record records that the supplied generation of the resource was
+// successfully reconciled and that its next resync is due at the supplied
+// time.
+func (t *resyncTracker) record(
+	nn k8stypes.NamespacedName,
+	generation int64,
+	due time.Time,
+) {
+	t.Lock()
+	defer t.Unlock()
+	t.entries[nn] = resyncEntry{generation: generation, due: due}
+}
+
+// forget removes the resync recorded for the resource, so that its next
+// reconciliation is not suppressed.
+func (t *resyncTracker) forget(nn k8stypes.NamespacedName) {
+	t.Lock()
+	defer t.Unlock()
+	delete(t.entries, nn)
+}
+
+// pending returns the time remaining before the resync of the resource is
+// due, and true, if a reconciliation of the supplied generation of the
+// resource would be redundant because an earlier reconciliation of the same
+// generation already scheduled a later resync.
+func (t *resyncTracker) pending(
+	nn k8stypes.NamespacedName,
+	generation int64,
+	now time.Time,
+) (time.Duration, bool) {
+	t.Lock()
+	defer t.Unlock()
+	entry, ok := t.entries[nn]
+	if !ok || entry.generation != generation {
+		return 0, false
+	}
+	if !now.Add(resyncCoalescingTolerance).Before(entry.due) {
+		return 0, false
+	}
+	return entry.due.Sub(now), true
+}
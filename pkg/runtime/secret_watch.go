@@ -0,0 +1,71 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+)
+
+// secretGroupKind is the GroupKind of the Secrets referred to by
+// SecretKeyReferences
+var secretGroupKind = metav1.GroupKind{Kind: "Secret"}
+
+// secretReferenceFields are the fields of Secrets the resources reading them
+// depend on
+var secretReferenceFields = []string{"data"}
+
+type referrerContextKeyType struct{}
+
+// referrerContextKey is the Context key of the namespaced name of the
+// resource being reconciled, to which the Secrets read during its
+// reconciliation are attributed.
+var referrerContextKey = referrerContextKeyType{}
+
+// withReferrer returns a copy of the supplied Context recording that the
+// resource with the supplied namespaced name is being reconciled.
+func withReferrer(ctx context.Context, nn k8stypes.NamespacedName) context.Context {
+	return context.WithValue(ctx, referrerContextKey, nn)
+}
+
+// SecretValueFromReference fetches the value of a Secret given a
+// SecretKeyReference.
+//
+// When the --watch-referenced-secrets flag is set, the Secret is recorded as
+// read by the resource being reconciled, which is reconciled again when the
+// data of the Secret changes. The Secret is recorded even when it cannot be
+// read, so that the resource is reconciled again once it is created.
+func (r *resourceReconciler) SecretValueFromReference(
+	ctx context.Context,
+	ref *ackv1alpha1.SecretKeyReference,
+) (string, error) {
+	if ref != nil && r.cfg.WatchReferencedSecrets {
+		if referrer, ok := ctx.Value(referrerContextKey).(k8stypes.NamespacedName); ok {
+			namespace := ref.Namespace
+			if namespace == "" {
+				namespace = "default"
+			}
+			r.secretReferences.add(
+				referrer,
+				referenceNodeKey(secretGroupKind, namespace, ref.Name),
+				secretReferenceFields,
+			)
+		}
+	}
+	return r.reconciler.SecretValueFromReference(ctx, ref)
+}
@@ -14,6 +14,8 @@
 package runtime
 
 import (
+	"context"
+	"fmt"
 	"strings"
 	"sync"
 
@@ -25,6 +27,7 @@ import (
 	kubernetes "k8s.io/client-go/kubernetes"
 	ctrlrt "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
 	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
@@ -181,6 +184,12 @@ func (c *serviceController) BindControllerManager(mgr ctrlrt.Manager, cfg ackcfg
 	c.metaLock.Lock()
 	defer c.metaLock.Unlock()
 
+	// Fail fast on misconfigurations rather than when the first resource is
+	// reconciled.
+	if err := cfg.ValidateReconcileConfig(); err != nil {
+		return fmt.Errorf("invalid controller configuration: %v", err)
+	}
+
 	cache := ackrtcache.New(c.log)
 	if cfg.WatchNamespace == "" {
 		clusterConfig := mgr.GetConfig()
@@ -219,6 +228,35 @@ func (c *serviceController) BindControllerManager(mgr ctrlrt.Manager, cfg ackcfg
 		c.fieldExportReconciler = rec
 	}
 
+	if c.metrics != nil {
+		if cfg.EnableMetricsInstanceLabel {
+			c.metrics.SetInstance(cfg.InstanceIdentity)
+		}
+		// Runnables needing leader election are only started once the
+		// controller instance is elected, which is reflected by the
+		// ack_leader metric.
+		c.metrics.RecordLeader(false)
+		err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			c.metrics.RecordLeader(true)
+			<-ctx.Done()
+			c.metrics.RecordLeader(false)
+			return nil
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
+	if cfg.DebugServerAddr != "" {
+		debugLogger := c.log.WithName("debug")
+		err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			return runDebugServer(ctx, debugLogger, cfg.DebugServerAddr, c.GetReconcilers)
+		}))
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, rmf := range c.rmFactories {
 		rec := NewReconciler(c, rmf, c.log, cfg, c.metrics, cache)
 		if err := rec.BindControllerManager(mgr); err != nil {
@@ -0,0 +1,68 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"sync"
+
+	ackcfg "github.com/aws-controllers-k8s/runtime/pkg/config"
+)
+
+var (
+	// serviceLimiters maps AWS service aliases to the semaphores limiting the
+	// number of concurrent reconciliations of the service's resources. The
+	// semaphores are shared by all the reconcilers in the controller binary.
+	serviceLimiters   = map[string]chan struct{}{}
+	serviceLimitersMu sync.Mutex
+)
+
+// serviceLimiterFor returns the semaphore limiting the number of concurrent
+// reconciliations of the supplied service's resources, creating it on first
+// use. It returns nil if the concurrency of the service is not limited.
+func serviceLimiterFor(
+	cfg ackcfg.Config,
+	serviceAlias string,
+) chan struct{} {
+	serviceLimitersMu.Lock()
+	defer serviceLimitersMu.Unlock()
+	if limiter, ok := serviceLimiters[serviceAlias]; ok {
+		return limiter
+	}
+	var limiter chan struct{}
+	if limit := cfg.GetServiceMaxConcurrentReconciles(serviceAlias); limit > 0 {
+		limiter = make(chan struct{}, limit)
+	}
+	serviceLimiters[serviceAlias] = limiter
+	return limiter
+}
+
+// acquireServiceSlot blocks until the reconciler is allowed to reconcile one
+// more resource of its AWS service, or until the supplied context is done. It
+// returns a function that must be called to release the slot once the
+// reconciliation is over.
+func (r *resourceReconciler) acquireServiceSlot(
+	ctx context.Context,
+) (func(), error) {
+	limiter := serviceLimiterFor(r.cfg, r.sc.GetMetadata().ServiceAlias)
+	if limiter == nil {
+		return func() {}, nil
+	}
+	select {
+	case limiter <- struct{}{}:
+		return func() { <-limiter }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+)
+
+// credentialExpiryErrorCodes are the codes of the AWS errors indicating that
+// the credentials of a session expired or were rotated.
+var credentialExpiryErrorCodes = map[string]bool{
+	"ExpiredToken":                true,
+	"ExpiredTokenException":       true,
+	"InvalidClientTokenId":        true,
+	"UnrecognizedClientException": true,
+}
+
+// IsCredentialExpiryError returns true if the supplied error is, or wraps, an
+// AWS error indicating that the credentials used to make the request expired
+// or were rotated.
+func IsCredentialExpiryError(err error) bool {
+	var awsErr awserr.Error
+	if !errors.As(err, &awsErr) {
+		return false
+	}
+	return credentialExpiryErrorCodes[awsErr.Code()]
+}
+
+// sessionRefreshWindow is how long before the expiry of its credentials a
+// cached session is discarded, so that reconciliations do not start with
+// credentials about to expire.
+const sessionRefreshWindow = 5 * time.Minute
+
+// sessionCacheKey identifies the AWS sessions that can be shared between the
+// reconciliations of different resources.
+type sessionCacheKey struct {
+	account  ackv1alpha1.AWSAccountID
+	region   ackv1alpha1.AWSRegion
+	roleARN  ackv1alpha1.AWSResourceName
+	endpoint string
+	gvk      schema.GroupVersionKind
+}
+
+// sessionCacheEntry is a cached AWS session
+type sessionCacheEntry struct {
+	sess    *session.Session
+	expires time.Time
+}
+
+// sessionCache keeps the AWS sessions created by a reconciler, so that
+// reconciliations with the same account, region, role and endpoint reuse the
+// same session, and its credentials, instead of creating a new session and
+// assuming the role again.
+type sessionCache struct {
+	sync.Mutex
+	// ttl is how long a session is reused after it was created
+	ttl     time.Duration
+	entries map[sessionCacheKey]sessionCacheEntry
+}
+
+// newSessionCache returns a new, empty sessionCache whose sessions are
+// reused for the supplied duration.
+func newSessionCache(ttl time.Duration) *sessionCache {
+	return &sessionCache{
+		ttl:     ttl,
+		entries: map[sessionCacheKey]sessionCacheEntry{},
+	}
+}
+
+// get returns the session cached for the supplied key, if it has not expired
+// and its credentials, once retrieved, do not expire within
+// sessionRefreshWindow.
+func (c *sessionCache) get(
+	key sessionCacheKey,
+	now time.Time,
+) (*session.Session, bool) {
+	c.Lock()
+	defer c.Unlock()
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	expires := entry.expires
+	if credsExpiry, ok := credentialsExpiry(entry.sess); ok &&
+		credsExpiry.Add(-sessionRefreshWindow).Before(expires) {
+		expires = credsExpiry.Add(-sessionRefreshWindow)
+	}
+	if !now.Before(expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.sess, true
+}
+
+// credentialsExpiry returns the time at which the credentials of the supplied
+// session, e.g. the ones of an assumed role, expire. It returns false if the
+// credentials do not expire or were not retrieved yet.
+func credentialsExpiry(sess *session.Session) (time.Time, bool) {
+	if sess == nil || sess.Config == nil || sess.Config.Credentials == nil {
+		return time.Time{}, false
+	}
+	expiry, err := sess.Config.Credentials.ExpiresAt()
+	if err != nil || expiry.IsZero() {
+		return time.Time{}, false
+	}
+	return expiry, true
+}
+
+// put caches the supplied session for the supplied key
+func (c *sessionCache) put(
+	key sessionCacheKey,
+	sess *session.Session,
+	now time.Time,
+) {
+	c.Lock()
+	defer c.Unlock()
+	c.entries[key] = sessionCacheEntry{sess: sess, expires: now.Add(c.ttl)}
+}
+
+// invalidate removes the session cached for the supplied key, so that the
+// next reconciliation creates a new session.
+func (c *sessionCache) invalidate(key sessionCacheKey) {
+	c.Lock()
+	defer c.Unlock()
+	delete(c.entries, key)
+}
+
+// getSession returns the AWS session used to reconcile resources in the
+// supplied account and region, reusing a cached session when session
+// caching is enabled.
+func (r *resourceReconciler) getSession(
+	key sessionCacheKey,
+) (*session.Session, error) {
+	if r.sessions != nil {
+		if sess, ok := r.sessions.get(key, time.Now()); ok {
+			return sess, nil
+		}
+	}
+	endpoint := key.endpoint
+	sess, err := r.sc.NewSession(key.region, &endpoint, key.roleARN, key.gvk)
+	if err != nil {
+		return nil, err
+	}
+	if r.sessions != nil {
+		r.sessions.put(key, sess, time.Now())
+	}
+	return sess, nil
+}
+
+// invalidateSession removes the session cached for the supplied key if the
+// supplied reconciliation error indicates that its credentials expired.
+func (r *resourceReconciler) invalidateSession(
+	key sessionCacheKey,
+	err error,
+) {
+	if r.sessions == nil || !IsCredentialExpiryError(err) {
+		return
+	}
+	r.log.V(1).Info(
+		"invalidating cached AWS session after credential expiry",
+		"account", key.account,
+		"region", key.region,
+		"role", key.roleARN,
+	)
+	r.sessions.invalidate(key)
+}
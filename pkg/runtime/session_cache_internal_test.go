@@ -0,0 +1,124 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// expiringProvider is a credentials provider whose credentials expire at a
+// fixed time, like the ones of an assumed role.
+type expiringProvider struct {
+	credentials.Expiry
+	expiresAt time.Time
+}
+
+func (p *expiringProvider) Retrieve() (credentials.Value, error) {
+	p.SetExpiration(p.expiresAt, 0)
+	return credentials.Value{
+		AccessKeyID:     "AKID",
+		SecretAccessKey: "SECRET",
+		ProviderName:    "expiringProvider",
+	}, nil
+}
+
+func sessionWithCredentials(creds *credentials.Credentials) *session.Session {
+	return &session.Session{Config: &aws.Config{Credentials: creds}}
+}
+
+func TestCredentialsExpiry(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	_, ok := credentialsExpiry(nil)
+	assert.False(ok)
+	_, ok = credentialsExpiry(&session.Session{})
+	assert.False(ok)
+
+	// static credentials never expire
+	static := sessionWithCredentials(
+		credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	)
+	_, ok = credentialsExpiry(static)
+	assert.False(ok)
+
+	expiresAt := time.Now().Add(time.Hour).Round(0)
+	assumed := sessionWithCredentials(
+		credentials.NewCredentials(&expiringProvider{expiresAt: expiresAt}),
+	)
+	// the expiry is unknown until the credentials are retrieved
+	_, ok = credentialsExpiry(assumed)
+	assert.False(ok)
+
+	_, err := assumed.Config.Credentials.Get()
+	require.Nil(err)
+	expiry, ok := credentialsExpiry(assumed)
+	require.True(ok)
+	assert.True(expiresAt.Equal(expiry))
+}
+
+func TestSessionCacheGet(t *testing.T) {
+	assert := assert.New(t)
+
+	now := time.Now()
+	key := sessionCacheKey{region: "us-west-2"}
+	c := newSessionCache(15 * time.Minute)
+
+	_, ok := c.get(key, now)
+	assert.False(ok)
+
+	sess := sessionWithCredentials(
+		credentials.NewStaticCredentials("AKID", "SECRET", ""),
+	)
+	c.put(key, sess, now)
+	got, ok := c.get(key, now.Add(time.Minute))
+	assert.True(ok)
+	assert.Same(sess, got)
+
+	// entries older than the TTL are evicted
+	_, ok = c.get(key, now.Add(15*time.Minute))
+	assert.False(ok)
+	assert.NotContains(c.entries, key)
+}
+
+func TestSessionCacheGetCredentialsNearExpiry(t *testing.T) {
+	require := require.New(t)
+	assert := assert.New(t)
+
+	now := time.Now()
+	key := sessionCacheKey{region: "us-west-2", roleARN: "arn:aws:iam::123456789012:role/ack"}
+	c := newSessionCache(time.Hour)
+
+	sess := sessionWithCredentials(credentials.NewCredentials(
+		&expiringProvider{expiresAt: now.Add(10 * time.Minute)},
+	))
+	_, err := sess.Config.Credentials.Get()
+	require.Nil(err)
+	c.put(key, sess, now)
+
+	// the session is reused until the credentials are within the refresh
+	// window, even though the TTL did not elapse yet
+	_, ok := c.get(key, now.Add(4*time.Minute))
+	assert.True(ok)
+	_, ok = c.get(key, now.Add(10*time.Minute-sessionRefreshWindow))
+	assert.False(ok)
+	assert.NotContains(c.entries, key)
+}
@@ -0,0 +1,39 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestIsCredentialExpiryError(t *testing.T) {
+	assert := assert.New(t)
+
+	expired := awserr.New("ExpiredTokenException", "The security token included in the request is expired", nil)
+	assert.True(ackrt.IsCredentialExpiryError(expired))
+	assert.True(ackrt.IsCredentialExpiryError(fmt.Errorf("update failed: %w", expired)))
+	assert.True(ackrt.IsCredentialExpiryError(requeue.NeededAfter(expired, 0)))
+
+	assert.False(ackrt.IsCredentialExpiryError(nil))
+	assert.False(ackrt.IsCredentialExpiryError(errors.New("ExpiredToken")))
+	assert.False(ackrt.IsCredentialExpiryError(awserr.New("ThrottlingException", "Rate exceeded", nil)))
+}
@@ -0,0 +1,121 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+
+	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
+	ackcondition "github.com/aws-controllers-k8s/runtime/pkg/condition"
+	"github.com/aws-controllers-k8s/runtime/pkg/requeue"
+	ackrtlog "github.com/aws-controllers-k8s/runtime/pkg/runtime/log"
+	acktypes "github.com/aws-controllers-k8s/runtime/pkg/types"
+)
+
+// softDeleteResource handles the deletion of a resource with the
+// "soft-delete" deletion policy. It returns true once the retention period
+// has elapsed and the backend AWS resource must be deleted.
+//
+// The first time a soft-deleted resource is reconciled, its backend AWS
+// resource is marked as pending deletion by resource managers implementing
+// AWSResourceManagerSoftDeleter, and the time after which it is deleted is
+// recorded in the services.k8s.aws/soft-delete-after annotation so that the
+// retention period survives controller restarts. Until then, the resource is
+// requeued for when the retention period ends.
+//
+// If the services.k8s.aws/cancel-soft-delete annotation is set to "true"
+// during the retention period, the pending deletion marker is removed and the
+// resource is handled as with the "retain" deletion policy.
+func (r *resourceReconciler) softDeleteResource(
+	ctx context.Context,
+	rm acktypes.AWSResourceManager,
+	res acktypes.AWSResource,
+) (acktypes.AWSResource, bool, error) {
+	var err error
+	rlog := ackrtlog.FromContext(ctx)
+	exit := rlog.Trace("r.softDeleteResource")
+	defer func() {
+		exit(err)
+	}()
+
+	softDeleter, canMark := rm.(acktypes.AWSResourceManagerSoftDeleter)
+
+	if IsSoftDeleteCancelled(res) {
+		if canMark {
+			rlog.Enter("rm.UnmarkPendingDeletion")
+			err = softDeleter.UnmarkPendingDeletion(ctx, res)
+			rlog.Exit("rm.UnmarkPendingDeletion", err)
+			if err != nil {
+				return res, false, err
+			}
+		}
+		rlog.Info("AWS resource will not be deleted - soft delete cancelled")
+		err = r.setResourceUnmanaged(ctx, res)
+		return res, false, err
+	}
+
+	deleteAfter, err := GetSoftDeleteAfter(res)
+	if err != nil {
+		rlog.Info(
+			"ignoring invalid soft delete time",
+			"annotation", ackv1alpha1.AnnotationSoftDeleteAfter,
+			"error", err.Error(),
+		)
+		deleteAfter = nil
+	}
+	if deleteAfter == nil {
+		t := time.Now().Add(
+			time.Duration(r.cfg.SoftDeleteRetentionSeconds) * time.Second,
+		).UTC().Truncate(time.Second)
+		deleteAfter = &t
+		if canMark {
+			rlog.Enter("rm.MarkPendingDeletion")
+			err = softDeleter.MarkPendingDeletion(ctx, res, t)
+			rlog.Exit("rm.MarkPendingDeletion", err)
+			if err != nil {
+				return res, false, err
+			}
+		}
+		orig := res.DeepCopy()
+		annotations := res.MetaObject().GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[ackv1alpha1.AnnotationSoftDeleteAfter] = t.Format(time.RFC3339)
+		res.MetaObject().SetAnnotations(annotations)
+		if err = r.patchResourceMetadataAndSpec(ctx, orig, res); err != nil {
+			return res, false, err
+		}
+		rlog.Info("AWS resource marked as pending deletion", "delete_after", t)
+	}
+
+	remaining := time.Until(*deleteAfter)
+	if remaining <= 0 {
+		return res, true, nil
+	}
+	reason := fmt.Sprintf(
+		"The AWS resource will be deleted after %s. Set the %s annotation "+
+			"to \"true\" to retain it",
+		deleteAfter.Format(time.RFC3339), ackv1alpha1.AnnotationCancelSoftDelete,
+	)
+	ackcondition.SetAdvisory(
+		res, corev1.ConditionTrue, &ackcondition.SoftDeletePendingMessage, &reason,
+	)
+	err = requeue.NeededAfter(nil, remaining)
+	return res, false, err
+}
@@ -0,0 +1,72 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sruntime "k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/encryption"
+)
+
+// EncryptStatusFields encrypts, in place, the string values of the latest
+// object found at the supplied paths, in dotted JSON notation, e.g.
+// "status.endpoint.address". Values that are absent, empty or already
+// encrypted are left untouched.
+//
+// When the prior object holds an encrypted value whose plaintext matches the
+// latest value, that encrypted value is reused so that the Status of the
+// resource is not patched again on every reconciliation.
+func EncryptStatusFields(
+	key []byte,
+	paths []string,
+	prior client.Object,
+	latest client.Object,
+) error {
+	content, err := k8sruntime.DefaultUnstructuredConverter.ToUnstructured(latest)
+	if err != nil {
+		return err
+	}
+	var priorContent map[string]interface{}
+	if prior != nil {
+		priorContent, _ = k8sruntime.DefaultUnstructuredConverter.ToUnstructured(prior)
+	}
+	for _, path := range paths {
+		fields := strings.Split(path, ".")
+		value, found, err := unstructured.NestedString(content, fields...)
+		if err != nil || !found || value == "" || encryption.IsEncrypted(value) {
+			continue
+		}
+		priorValue, _, _ := unstructured.NestedString(priorContent, fields...)
+		if encryption.IsEncrypted(priorValue) {
+			if decrypted, err := encryption.Decrypt(key, priorValue); err == nil && decrypted == value {
+				if err := unstructured.SetNestedField(content, priorValue, fields...); err != nil {
+					return err
+				}
+				continue
+			}
+		}
+		encrypted, err := encryption.Encrypt(key, value)
+		if err != nil {
+			return err
+		}
+		if err := unstructured.SetNestedField(content, encrypted, fields...); err != nil {
+			return err
+		}
+	}
+	return k8sruntime.DefaultUnstructuredConverter.FromUnstructured(content, latest)
+}
@@ -0,0 +1,70 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	k8sobj "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/aws-controllers-k8s/runtime/pkg/encryption"
+	ackrt "github.com/aws-controllers-k8s/runtime/pkg/runtime"
+)
+
+func TestEncryptStatusFields(t *testing.T) {
+	require := require.New(t)
+	key := bytes.Repeat([]byte{1}, encryption.KeySize)
+	paths := []string{"status.endpoint.address", "status.token"}
+
+	latest := &k8sobj.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "rds.services.k8s.aws/v1alpha1",
+		"kind":       "DBInstance",
+		"status": map[string]interface{}{
+			"endpoint": map[string]interface{}{
+				"address": "mydb.example.com",
+				"port":    int64(5432),
+			},
+			"dbInstanceStatus": "available",
+		},
+	}}
+	require.Nil(ackrt.EncryptStatusFields(key, paths, nil, latest))
+
+	address, _, _ := k8sobj.NestedString(latest.Object, "status", "endpoint", "address")
+	require.True(encryption.IsEncrypted(address))
+	decrypted, err := encryption.Decrypt(key, address)
+	require.Nil(err)
+	require.Equal("mydb.example.com", decrypted)
+
+	// Other fields are left in plaintext, and absent fields are not added
+	status, _, _ := k8sobj.NestedString(latest.Object, "status", "dbInstanceStatus")
+	require.Equal("available", status)
+	_, found, _ := k8sobj.NestedString(latest.Object, "status", "token")
+	require.False(found)
+
+	// The encrypted value of an unchanged field is reused
+	next := &k8sobj.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "rds.services.k8s.aws/v1alpha1",
+		"kind":       "DBInstance",
+		"status": map[string]interface{}{
+			"endpoint": map[string]interface{}{
+				"address": "mydb.example.com",
+			},
+		},
+	}}
+	require.Nil(ackrt.EncryptStatusFields(key, paths, latest, next))
+	nextAddress, _, _ := k8sobj.NestedString(next.Object, "status", "endpoint", "address")
+	require.Equal(address, nextAddress)
+}
@@ -77,14 +77,16 @@ var ACKResourceTagFormats = map[string]resolveTagFormat{
 	},
 }
 
-// GetDefaultTags provides Default tags (key value pairs) for given resource
+// GetDefaultTags provides Default tags (key value pairs) for given resource.
+// The tags propagated from the labels configured with --label-tag-keys are
+// included, the tags configured with --resource-tags taking precedence.
 func GetDefaultTags(
 	config *ackconfig.Config,
 	obj rtclient.Object,
 	md acktypes.ServiceControllerMetadata,
 ) acktags.Tags {
 	defaultTags := acktags.NewTags()
-	if obj == nil || config == nil || len(config.ResourceTags) == 0 {
+	if obj == nil || config == nil {
 		return defaultTags
 	}
 	for _, tagKeyVal := range config.ResourceTags {
@@ -99,7 +101,7 @@ func GetDefaultTags(
 		}
 		defaultTags[key] = expandTagValue(val, obj, md)
 	}
-	return defaultTags
+	return acktags.Merge(defaultTags, GetLabelTags(config, obj))
 }
 
 // expandTagValue returns the tag value after expanding all the ACKResourceTag
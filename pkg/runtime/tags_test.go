@@ -18,6 +18,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	k8sobj "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	mocks "github.com/aws-controllers-k8s/runtime/mocks/controller-runtime/pkg/client"
 	"github.com/aws-controllers-k8s/runtime/pkg/config"
@@ -87,3 +88,78 @@ func TestGetDefaultTags(t *testing.T) {
 	assert.Equal("ns", expandedTags["services.k8s.aws/namespace"])
 	assert.Equal("res", expandedTags["services.k8s.aws/name"])
 }
+
+func TestGetLabelTags(t *testing.T) {
+	assert := assert.New(t)
+	obj := &k8sobj.Unstructured{}
+	obj.SetNamespace("ns")
+	obj.SetName("res")
+	obj.SetLabels(map[string]string{
+		"team":                   "payments",
+		"app.kubernetes.io/name": "checkout",
+		"unpropagated":           "value",
+	})
+
+	cfg := config.Config{
+		ResourceTags: []string{"team=platform"},
+		LabelTagKeys: []string{"team", "app.kubernetes.io/name", "missing"},
+	}
+	labelTags := runtime.GetLabelTags(&cfg, obj)
+	assert.Equal(acktags.Tags{"team": "payments", "app.kubernetes.io/name": "checkout"}, labelTags)
+
+	// Configured resource tags take precedence over label tags
+	defaultTags := runtime.GetDefaultTags(&cfg, obj, acktypes.ServiceControllerMetadata{})
+	assert.Equal("platform", defaultTags["team"])
+	assert.Equal("checkout", defaultTags["app.kubernetes.io/name"])
+
+	assert.Equal("a_b_c", runtime.SanitizeTag("a#b!c", 128))
+	assert.Equal("abc", runtime.SanitizeTag("abcdef", 3))
+}
+
+func TestSetSpecTags(t *testing.T) {
+	assert := assert.New(t)
+
+	// Tags as a map
+	obj := &k8sobj.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "s3.services.k8s.aws/v1alpha1",
+		"kind":       "Bucket",
+		"spec": map[string]interface{}{
+			"tags": map[string]interface{}{"team": "old", "stale": "x", "other": "y"},
+		},
+	}}
+	ok, err := runtime.SetSpecTags(obj, acktags.Tags{"team": "new"}, []string{"stale"})
+	assert.Nil(err)
+	assert.True(ok)
+	tags, _, _ := k8sobj.NestedStringMap(obj.Object, "spec", "tags")
+	assert.Equal(map[string]string{"team": "new", "other": "y"}, tags)
+
+	// Tags as a list of key/value objects
+	obj = &k8sobj.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "s3.services.k8s.aws/v1alpha1",
+		"kind":       "Bucket",
+		"spec": map[string]interface{}{
+			"tags": []interface{}{
+				map[string]interface{}{"key": "team", "value": "old"},
+				map[string]interface{}{"key": "stale", "value": "x"},
+			},
+		},
+	}}
+	ok, err = runtime.SetSpecTags(obj, acktags.Tags{"team": "new", "app": "checkout"}, []string{"stale"})
+	assert.Nil(err)
+	assert.True(ok)
+	list, _, _ := k8sobj.NestedSlice(obj.Object, "spec", "tags")
+	assert.Equal([]interface{}{
+		map[string]interface{}{"key": "team", "value": "new"},
+		map[string]interface{}{"key": "app", "value": "checkout"},
+	}, list)
+
+	// Resources without tags are left unchanged
+	obj = &k8sobj.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "s3.services.k8s.aws/v1alpha1",
+		"kind":       "Bucket",
+		"spec":       map[string]interface{}{"name": "res"},
+	}}
+	ok, err = runtime.SetSpecTags(obj, acktags.Tags{"team": "new"}, nil)
+	assert.Nil(err)
+	assert.False(ok)
+}
@@ -0,0 +1,94 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	ackerr "github.com/aws-controllers-k8s/runtime/pkg/errors"
+)
+
+// tracerName is the name of the tracer recording the spans of the
+// reconcilers, identifying the instrumentation library.
+const tracerName = "github.com/aws-controllers-k8s/runtime"
+
+// tracer returns the tracer of the provider configured in
+// Config.TracerProvider, or a tracer recording nothing if no provider is
+// configured.
+func (r *resourceReconciler) tracer() trace.Tracer {
+	tp := r.cfg.TracerProvider
+	if tp == nil {
+		tp = trace.NewNoopTracerProvider()
+	}
+	return tp.Tracer(tracerName)
+}
+
+// startReconcileSpan starts the root span of the reconciliation of a
+// resource, carrying the identity of the resource and of its AWS account
+// and region as attributes.
+func (r *resourceReconciler) startReconcileSpan(
+	ctx context.Context,
+	namespace string,
+	name string,
+	account string,
+	region string,
+) (context.Context, trace.Span) {
+	return r.tracer().Start(
+		ctx, "Reconcile",
+		trace.WithAttributes(
+			attribute.String("kind", r.rd.GroupKind().Kind),
+			attribute.String("namespace", namespace),
+			attribute.String("name", name),
+			attribute.String("account", account),
+			attribute.String("region", region),
+		),
+	)
+}
+
+// startOperation starts a span for the supplied resource manager operation,
+// child of the reconciliation span in the supplied Context, and returns the
+// function to call with the error returned by the operation once it returns.
+// That function ends the span and records the duration of the operation.
+//
+// The span is not propagated to the resource manager, so that the Context
+// passed to it is the one of the reconciliation.
+func (r *resourceReconciler) startOperation(
+	ctx context.Context,
+	operation string,
+) func(error) {
+	_, span := r.tracer().Start(ctx, "rm."+operation)
+	start := time.Now()
+	return func(err error) {
+		r.recordOperationDuration(operation, start)
+		setSpanError(span, err)
+		span.End()
+	}
+}
+
+// setSpanError records the supplied error on the supplied span and sets the
+// status of the span to Error, unless the error is a NotFound error or a
+// requeue request, which are expected outcomes of reconciliations.
+func setSpanError(span trace.Span, err error) {
+	if err == nil || errors.Is(err, ackerr.NotFound) || isRequeueOnly(err) {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
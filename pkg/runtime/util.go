@@ -15,6 +15,7 @@ package runtime
 
 import (
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -42,6 +43,83 @@ func IsAdopted(res acktypes.AWSResource) bool {
 	return false
 }
 
+// IsConversionWebhookError returns true if the supplied error was returned by
+// the Kubernetes API server because the conversion webhook of a CRD serving
+// multiple versions could not convert the requested object, e.g. because the
+// webhook is unavailable during an upgrade.
+func IsConversionWebhookError(err error) bool {
+	return err != nil && strings.Contains(
+		strings.ToLower(err.Error()), "conversion webhook",
+	)
+}
+
+// GetApplyAfter returns the time set in the services.k8s.aws/apply-after
+// annotation of the supplied AWSResource, before which updates to the backend
+// AWS resource are deferred. It returns nil if the annotation is not set, and
+// an error if its value is not an RFC3339 timestamp.
+func GetApplyAfter(res acktypes.AWSResource) (*time.Time, error) {
+	value, ok := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationApplyAfter]
+	if !ok {
+		return nil, nil
+	}
+	applyAfter, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &applyAfter, nil
+}
+
+// IsDeletionProtected returns true if the supplied AWSResource has the
+// services.k8s.aws/deletion-protection annotation set to "true", which
+// indicates that the backend AWS resource must not be deleted when the CR is
+// deleted.
+func IsDeletionProtected(res acktypes.AWSResource) bool {
+	value := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationDeletionProtection]
+	return strings.ToLower(value) == "true"
+}
+
+// IsSoftDeleteCancelled returns true if the supplied AWSResource has the
+// services.k8s.aws/cancel-soft-delete annotation set to "true", which
+// indicates that the backend AWS resource of a CR pending soft deletion must
+// be retained.
+func IsSoftDeleteCancelled(res acktypes.AWSResource) bool {
+	value := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationCancelSoftDelete]
+	return strings.ToLower(value) == "true"
+}
+
+// GetSoftDeleteAfter returns the time set in the
+// services.k8s.aws/soft-delete-after annotation of the supplied AWSResource,
+// after which its soft-deleted backend AWS resource is deleted. It returns nil
+// if the annotation is not set, and an error if its value is not an RFC3339
+// timestamp.
+func GetSoftDeleteAfter(res acktypes.AWSResource) (*time.Time, error) {
+	value, ok := res.MetaObject().GetAnnotations()[ackv1alpha1.AnnotationSoftDeleteAfter]
+	if !ok {
+		return nil, nil
+	}
+	deleteAfter, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &deleteAfter, nil
+}
+
+// MissingRequiredAnnotations returns the keys of the supplied required
+// annotations that the supplied AWSResource does not carry.
+func MissingRequiredAnnotations(
+	res acktypes.AWSResource,
+	required []string,
+) []string {
+	annotations := res.MetaObject().GetAnnotations()
+	missing := []string{}
+	for _, key := range required {
+		if _, ok := annotations[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
 // IsSynced returns true if the supplied AWSResource's CR and associated
 // backend AWS service API resource are in sync.
 func IsSynced(res acktypes.AWSResource) bool {
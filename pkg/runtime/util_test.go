@@ -14,10 +14,13 @@
 package runtime_test
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	ackv1alpha1 "github.com/aws-controllers-k8s/runtime/apis/core/v1alpha1"
@@ -67,3 +70,101 @@ func TestIsSynced(t *testing.T) {
 	})
 	require.False(ackrt.IsSynced(res))
 }
+
+func TestIsDeletionProtected(t *testing.T) {
+	require := require.New(t)
+
+	res := &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{
+		Annotations: map[string]string{
+			ackv1alpha1.AnnotationDeletionProtection: "True",
+		},
+	})
+	require.True(ackrt.IsDeletionProtected(res))
+
+	res = &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{
+		Annotations: map[string]string{
+			ackv1alpha1.AnnotationDeletionProtection: "false",
+		},
+	})
+	require.False(ackrt.IsDeletionProtected(res))
+
+	res = &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{})
+	require.False(ackrt.IsDeletionProtected(res))
+}
+
+func TestIsConversionWebhookError(t *testing.T) {
+	require := require.New(t)
+
+	require.False(ackrt.IsConversionWebhookError(nil))
+	require.False(ackrt.IsConversionWebhookError(errors.New("connection refused")))
+	require.True(ackrt.IsConversionWebhookError(apierrors.NewInternalError(errors.New(
+		"conversion webhook for s3.services.k8s.aws/v1alpha1, Kind=Bucket failed: " +
+			"Post \"https://ack-s3-webhook.ack-system.svc:443/convert\": connection refused",
+	))))
+}
+
+func TestGetApplyAfter(t *testing.T) {
+	require := require.New(t)
+
+	res := &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{})
+	applyAfter, err := ackrt.GetApplyAfter(res)
+	require.Nil(err)
+	require.Nil(applyAfter)
+
+	res = &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{
+		Annotations: map[string]string{
+			ackv1alpha1.AnnotationApplyAfter: "2024-01-31T22:00:00Z",
+		},
+	})
+	applyAfter, err = ackrt.GetApplyAfter(res)
+	require.Nil(err)
+	require.NotNil(applyAfter)
+	require.True(applyAfter.Equal(time.Date(2024, 1, 31, 22, 0, 0, 0, time.UTC)))
+
+	res = &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{
+		Annotations: map[string]string{
+			ackv1alpha1.AnnotationApplyAfter: "tomorrow",
+		},
+	})
+	_, err = ackrt.GetApplyAfter(res)
+	require.NotNil(err)
+}
+
+func TestSoftDeleteAnnotations(t *testing.T) {
+	require := require.New(t)
+
+	res := &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{})
+	require.False(ackrt.IsSoftDeleteCancelled(res))
+	deleteAfter, err := ackrt.GetSoftDeleteAfter(res)
+	require.Nil(err)
+	require.Nil(deleteAfter)
+
+	res = &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{
+		Annotations: map[string]string{
+			ackv1alpha1.AnnotationCancelSoftDelete: "TRUE",
+			ackv1alpha1.AnnotationSoftDeleteAfter:  "2024-01-31T22:00:00Z",
+		},
+	})
+	require.True(ackrt.IsSoftDeleteCancelled(res))
+	deleteAfter, err = ackrt.GetSoftDeleteAfter(res)
+	require.Nil(err)
+	require.NotNil(deleteAfter)
+	require.True(deleteAfter.Equal(time.Date(2024, 1, 31, 22, 0, 0, 0, time.UTC)))
+
+	res = &mocks.AWSResource{}
+	res.On("MetaObject").Return(&metav1.ObjectMeta{
+		Annotations: map[string]string{
+			ackv1alpha1.AnnotationSoftDeleteAfter: "next week",
+		},
+	})
+	_, err = ackrt.GetSoftDeleteAfter(res)
+	require.NotNil(err)
+}
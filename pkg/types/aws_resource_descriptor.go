@@ -59,3 +59,70 @@ type AWSResourceDescriptor interface {
 	// resource was not created from within ACK.
 	MarkAdopted(AWSResource)
 }
+
+// AWSResourceDestructiveFieldDescriptor is an optional interface that an
+// AWSResourceDescriptor may implement to declare the fields whose changes can
+// only be applied by destroying and replacing the backend AWS resource. The
+// ACK runtime will not apply changes to these fields unless the user confirms
+// them with the `services.k8s.aws/confirm-destructive-update` annotation.
+type AWSResourceDestructiveFieldDescriptor interface {
+	// DestructiveFields returns the paths, in dotted notation, of the fields
+	// whose changes are destructive, e.g. "Spec.Engine"
+	DestructiveFields() []string
+}
+
+// AWSResourceServerDefaultedFieldDescriptor is an optional interface that an
+// AWSResourceDescriptor may implement to declare the fields to which the AWS
+// service applies a default value when they are left unset. The ACK runtime
+// does not consider such a field as drifted when the user left it unset and
+// the AWS resource has a value for it, and does not try to clear it.
+type AWSResourceServerDefaultedFieldDescriptor interface {
+	// ServerDefaultedFields returns the paths, in dotted notation, of the
+	// fields defaulted by the AWS service, e.g. "Spec.EngineVersion"
+	ServerDefaultedFields() []string
+}
+
+// AWSResourceRequiredFieldDescriptor is an optional interface that an
+// AWSResourceDescriptor may implement to declare the values that fields of
+// the backend AWS resource must have, e.g. to require encryption. Values
+// configured with the `--required-field-values` flag take precedence.
+type AWSResourceRequiredFieldDescriptor interface {
+	// RequiredFieldValues returns the required values, keyed by the paths of
+	// the fields in dotted JSON notation, e.g. "spec.encryption.enabled"
+	RequiredFieldValues() map[string]string
+}
+
+// TaggingModel describes when the AWS service API of a resource accepts the
+// resource's tags.
+type TaggingModel string
+
+const (
+	// TaggingModelTagOnCreate indicates that tags are passed to the call
+	// creating the backend AWS resource
+	TaggingModelTagOnCreate TaggingModel = "tag-on-create"
+	// TaggingModelTagAfterCreate indicates that tags can only be applied to
+	// the backend AWS resource once it exists
+	TaggingModelTagAfterCreate TaggingModel = "tag-after-create"
+)
+
+// AWSResourceTaggingModelDescriptor is an optional interface that an
+// AWSResourceDescriptor may implement to declare the tagging model of the
+// resource. Resources whose descriptor does not implement this interface use
+// the TaggingModelTagOnCreate model, the controller tags being added to the
+// resource before it is created.
+type AWSResourceTaggingModelDescriptor interface {
+	// TaggingModel returns the tagging model of the resource
+	TaggingModel() TaggingModel
+}
+
+// AWSResourceClientAssignedIdentifierDescriptor is an optional interface that
+// an AWSResourceDescriptor may implement to declare whether the identifier of
+// the backend AWS resource is assigned by the client, e.g. a name specified
+// by the user, rather than generated by the AWS service. For such resources
+// the ACK runtime can skip the read preceding the creation of new resources,
+// see the `--skip-initial-read-kinds` flag.
+type AWSResourceClientAssignedIdentifierDescriptor interface {
+	// HasClientAssignedIdentifier returns true if the identifier of the
+	// backend AWS resource is assigned by the client
+	HasClientAssignedIdentifier() bool
+}
@@ -15,6 +15,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/go-logr/logr"
@@ -121,3 +122,70 @@ type AWSResourceManagerFactory interface {
 	// Default is false which means resource will not be requeued after success.
 	RequeueOnSuccessSeconds() int
 }
+
+// AWSResourceAdoptionMatcher is an optional interface that an
+// AWSResourceManager may implement in order to customize how the backend AWS
+// resource adopted by an AdoptedResource is located. By default, the ACK
+// runtime sets the AdoptedResource's AWS identifiers on an empty resource and
+// calls ReadOne. Implementers may instead locate the AWS resource by any of
+// its attributes, e.g. its name, ARN or tags, for instance using the
+// AdditionalKeys of the AdoptedResource's AWS identifiers.
+type AWSResourceAdoptionMatcher interface {
+	// MatchAdoptedResource returns the currently-observed state of the
+	// backend AWS resource matching the supplied AdoptedResource.
+	//
+	// Implementers should return (nil, ackerrors.NotFound) when no AWS
+	// resource matches the AdoptedResource.
+	MatchAdoptedResource(context.Context, *ackv1alpha1.AdoptedResource) (AWSResource, error)
+}
+
+// AWSResourceManagerSoftDeleter is an optional interface that an
+// AWSResourceManager may implement in order to mark backend AWS resources as
+// pending deletion, e.g. with a tag, while they are kept around by the
+// "soft-delete" deletion policy.
+type AWSResourceManagerSoftDeleter interface {
+	// MarkPendingDeletion marks the supplied AWSResource's backend AWS
+	// resource as pending deletion after the supplied time.
+	MarkPendingDeletion(ctx context.Context, res AWSResource, deleteAfter time.Time) error
+	// UnmarkPendingDeletion removes the marker set by MarkPendingDeletion
+	// from the supplied AWSResource's backend AWS resource.
+	UnmarkPendingDeletion(ctx context.Context, res AWSResource) error
+}
+
+// AWSResourceReferenceValidator is an optional interface that an
+// AWSResourceManager may implement in order to check, once the references of
+// a resource are resolved, that the resource's fields are consistent with the
+// attributes of the resources it references, e.g. that an instance and the
+// subnet it references are in the same VPC.
+type AWSResourceReferenceValidator interface {
+	// ValidateReferences returns an error wrapping
+	// ackerrors.ResourceReferenceMismatch, e.g. returned by
+	// ackerrors.ResourceReferenceMismatchFor, if the supplied resource with
+	// resolved references is inconsistent with the resources it references.
+	// The resource is then placed in an ACK.Terminal condition. Other errors
+	// fail the reconciliation and are retried.
+	ValidateReferences(context.Context, client.Reader, AWSResource) error
+}
+
+// AWSResourceCostEstimator is an optional interface that an
+// AWSResourceManager may implement in order to estimate the cost of its
+// backend AWS resources. The estimate is recorded on the CR and exposed as a
+// metric after the AWS resource is created or updated.
+type AWSResourceCostEstimator interface {
+	// EstimateMonthlyCost returns the estimated monthly cost, in US dollars,
+	// of the supplied AWSResource's backend AWS resource.
+	EstimateMonthlyCost(ctx context.Context, res AWSResource) (float64, error)
+}
+
+// ResyncPeriodResolver is an optional interface that an
+// AWSResourceManagerFactory may implement in order to compute the resync
+// period of each resource individually, for instance based on the resource's
+// labels or age.
+type ResyncPeriodResolver interface {
+	// ResolveResyncPeriod returns the duration to wait before resyncing the
+	// supplied AWSResource after a successful reconciliation. The supplied
+	// default period is the resync period the reconciler would otherwise use
+	// for the resource's kind. A non-positive return value means the default
+	// period should be used.
+	ResolveResyncPeriod(res AWSResource, defaultPeriod time.Duration) time.Duration
+}
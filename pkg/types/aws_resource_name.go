@@ -0,0 +1,28 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package types
+
+// AWSResourceWithSpecName is an optional interface implemented by AWSResources
+// whose name identifier is a Spec field that the Kubernetes user may leave
+// empty. When the Spec name is empty, the ACK runtime computes a deterministic
+// name for the resource and persists it to the Spec before the resource is
+// created.
+type AWSResourceWithSpecName interface {
+	// SpecName returns the value of the Spec field containing the name of the
+	// backend AWS resource, or the empty string if the field is not set.
+	SpecName() string
+	// SetSpecName sets the Spec field containing the name of the backend AWS
+	// resource.
+	SetSpecName(string)
+}
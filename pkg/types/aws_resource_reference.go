@@ -0,0 +1,46 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package types
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReferencedResource identifies a Kubernetes custom resource that is referred
+// to from one of an AWSResource's AWSResourceReference fields.
+type ReferencedResource struct {
+	// GroupKind is the API group and kind of the referenced resource
+	GroupKind metav1.GroupKind
+	// Namespace is the namespace of the referenced resource
+	Namespace string
+	// Name is the name of the referenced resource
+	Name string
+	// Fields are the paths, in dotted JSON notation, of the fields of the
+	// referenced resource that the referring resource depends on, e.g.
+	// "status.ackResourceMetadata.arn". The referring resource is only
+	// reconciled again when one of these fields changes. When empty, any
+	// change to the referenced resource triggers a reconciliation.
+	Fields []string
+}
+
+// AWSResourceReferenceDescriptor is an optional interface that an
+// AWSResourceDescriptor may implement in order to expose the references
+// between resources. The ACK runtime uses this reference graph to detect
+// resources that directly or indirectly reference themselves, and to
+// reconcile resources again when the resources they reference change.
+type AWSResourceReferenceDescriptor interface {
+	// ReferencedResources returns the resources referred to from the
+	// supplied AWSResource's reference fields
+	ReferencedResources(AWSResource) []ReferencedResource
+}
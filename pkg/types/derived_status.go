@@ -0,0 +1,31 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package types
+
+import "context"
+
+// DerivedStatusFunc computes one or more Status fields of the supplied
+// AWSResource from its latest observed state, e.g. a health summary combining
+// the results of several AWS API calls, and sets them on the resource.
+type DerivedStatusFunc func(ctx context.Context, latest AWSResource) error
+
+// DerivedStatusComputer is an optional interface that an AWSResourceManager
+// may implement in order to have the reconciler compute derived Status
+// fields of the resources it manages. The computations run at the end of
+// every successful synchronization of a resource, after the resource was
+// read from the AWS API and before its Status is patched.
+type DerivedStatusComputer interface {
+	// DerivedStatusComputations returns the computations to run, in order
+	DerivedStatusComputations() []DerivedStatusFunc
+}
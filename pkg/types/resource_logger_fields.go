@@ -0,0 +1,24 @@
+// Copyright Amazon.com Inc. or its affiliates. All Rights Reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"). You may
+// not use this file except in compliance with the License. A copy of the
+// License is located at
+//
+//     http://aws.amazon.com/apache2.0/
+//
+// or in the "license" file accompanying this file. This file is distributed
+// on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either
+// express or implied. See the License for the specific language governing
+// permissions and limitations under the License.
+
+package types
+
+// ResourceLoggerFieldsExtractor is an optional interface that an
+// AWSResourceManagerFactory may implement in order to add custom fields,
+// derived from the reconciled resource, to every log line written while
+// reconciling the resource.
+type ResourceLoggerFieldsExtractor interface {
+	// ResourceLoggerFields returns the key/value pairs added to the logger
+	// of the supplied AWSResource, e.g. []interface{}{"team", "payments"}
+	ResourceLoggerFields(res AWSResource) []interface{}
+}